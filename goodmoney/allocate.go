@@ -0,0 +1,359 @@
+package goodmoney
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"sort"
+)
+
+// AllocationStrategy decides which parties receive the minor units left over when
+// an amount is split in proportion to ratios and every share is rounded toward zero.
+// Strategies only give leftover units to parties with a positive ratio. A nil
+// strategy is RoundRobin.
+//
+// The strategies are RoundRobin, LargestRemainder, RemainderToLast,
+// RemainderToLargestShare and SeededRandom.
+type AllocationStrategy interface {
+	// distribute returns the leftover units each party receives, summing to leftover.
+	distribute(shares []allocationShare, leftover int64) []int64
+}
+
+// allocationShare is the exact share of one party: amount·weight/total is its
+// quota rounded toward zero plus remainder/total.
+type allocationShare struct {
+	weight    *big.Int
+	remainder *big.Int
+}
+
+// RoundRobin gives one leftover unit to each party in order, starting at the first.
+type RoundRobin struct{}
+
+// LargestRemainder gives one leftover unit to each party with the largest fraction
+// dropped from its exact share, the Hamilton method. Ties go to the earlier party.
+// Every party receives its exact share rounded down or up.
+type LargestRemainder struct{}
+
+// RemainderToLast gives every leftover unit to the last party.
+type RemainderToLast struct{}
+
+// RemainderToLargestShare gives every leftover unit to the party with the largest
+// ratio, the earliest one on ties.
+type RemainderToLargestShare struct{}
+
+// SeededRandom gives one leftover unit to parties drawn at random among those whose
+// exact share isn't whole. The draw only depends on Seed, so the same seed always
+// allocates the same way.
+type SeededRandom struct {
+	Seed uint64
+}
+
+func (RoundRobin) distribute(shares []allocationShare, leftover int64) []int64 {
+	extra := make([]int64, len(shares))
+	for i := 0; leftover > 0; i = (i + 1) % len(shares) {
+		if shares[i].weight.Sign() > 0 {
+			extra[i]++
+			leftover--
+		}
+	}
+	return extra
+}
+
+func (LargestRemainder) distribute(shares []allocationShare, leftover int64) []int64 {
+	order := make([]int, len(shares))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return shares[order[a]].remainder.Cmp(shares[order[b]].remainder) > 0
+	})
+
+	extra := make([]int64, len(shares))
+	for _, i := range order[:leftover] {
+		extra[i] = 1
+	}
+	return extra
+}
+
+func (RemainderToLast) distribute(shares []allocationShare, leftover int64) []int64 {
+	extra := make([]int64, len(shares))
+	for i := len(shares) - 1; i >= 0; i-- {
+		if shares[i].weight.Sign() > 0 {
+			extra[i] = leftover
+			break
+		}
+	}
+	return extra
+}
+
+func (RemainderToLargestShare) distribute(shares []allocationShare, leftover int64) []int64 {
+	largest := 0
+	for i, s := range shares {
+		if s.weight.Cmp(shares[largest].weight) > 0 {
+			largest = i
+		}
+	}
+	extra := make([]int64, len(shares))
+	extra[largest] = leftover
+	return extra
+}
+
+func (s SeededRandom) distribute(shares []allocationShare, leftover int64) []int64 {
+	var candidates []int
+	for i, share := range shares {
+		if share.remainder.Sign() > 0 {
+			candidates = append(candidates, i)
+		}
+	}
+
+	// Fisher-Yates on a PCG stream, spelled out so allocations don't change with
+	// the shuffling algorithms of math/rand
+	src := rand.NewPCG(s.Seed, 0)
+	for i := len(candidates) - 1; i > 0; i-- {
+		j := uniformUint64(src, uint64(i+1))
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	}
+
+	extra := make([]int64, len(shares))
+	for _, i := range candidates[:leftover] {
+		extra[i] = 1
+	}
+	return extra
+}
+
+// uniformUint64 returns a uniform random number in [0, n) from src by rejection.
+func uniformUint64(src rand.Source, n uint64) uint64 {
+	limit := math.MaxUint64 - math.MaxUint64%n
+	for {
+		if x := src.Uint64(); x < limit {
+			return x % n
+		}
+	}
+}
+
+// Allocation is the result of AllocateWith and AllocateByPercentWith.
+type Allocation struct {
+	// Parts are the allocated amounts, in the order of the ratios. They sum to the
+	// allocated amount.
+	Parts []*Money
+	// Extra is the number of leftover minor units each party received on top of its
+	// exact share rounded toward zero, with the sign of the allocated amount.
+	Extra []int64
+}
+
+// Receivers returns the indices of the parties that received leftover units.
+func (a Allocation) Receivers() []int {
+	var receivers []int
+	for i, extra := range a.Extra {
+		if extra != 0 {
+			receivers = append(receivers, i)
+		}
+	}
+	return receivers
+}
+
+// AllocateWith splits money by given ratios without losing pennies, like Allocate,
+// handing the leftover minor units out with the given strategy. The shares are
+// computed exactly, whatever the amount and ratios.
+// Returns an error wrapping ErrDivisionByZero if every ratio is zero and m isn't
+// zero, since nothing would receive the amount.
+//
+// Example:
+//
+//	a, err := total.AllocateWith(LargestRemainder{}, 3, 2, 1)
+//	a.Parts       // the three shares
+//	a.Receivers() // the parties that got a leftover cent
+func (m Money) AllocateWith(strategy AllocationStrategy, rs ...int) (*Allocation, error) {
+	if len(rs) == 0 {
+		return nil, errors.New("no ratios specified")
+	}
+
+	weights := make([]*big.Int, len(rs))
+	for i, r := range rs {
+		if r < 0 {
+			return nil, errors.New("negative ratios not allowed")
+		}
+		weights[i] = big.NewInt(int64(r))
+	}
+	return m.allocate(strategy, weights)
+}
+
+// AllocateByPercentWith splits money by exact percentages with the given strategy,
+// see AllocateWith. Percentages needn't add up to 100%, the shares are proportional
+// to them.
+// Returns an error wrapping ErrDivisionByZero if every percentage is zero and m
+// isn't zero.
+//
+// Example:
+//
+//	a, err := payment.AllocateByPercentWith(RemainderToLast{}, sixty, twentyFive, fifteen)
+func (m Money) AllocateByPercentWith(strategy AllocationStrategy, ps ...Percent) (*Allocation, error) {
+	if len(ps) == 0 {
+		return nil, errors.New("no percentages specified")
+	}
+
+	// bring the percentages to a common denominator
+	denominator := big.NewInt(1)
+	for _, p := range ps {
+		r := p.Rat()
+		if r.Sign() < 0 {
+			return nil, errors.New("negative percentages not allowed")
+		}
+		gcd := new(big.Int).GCD(nil, nil, denominator, r.Denom())
+		denominator.Mul(denominator, new(big.Int).Quo(r.Denom(), gcd))
+	}
+
+	weights := make([]*big.Int, len(ps))
+	for i, p := range ps {
+		r := p.Rat()
+		weights[i] = new(big.Int).Mul(r.Num(), new(big.Int).Quo(denominator, r.Denom()))
+	}
+	return m.allocate(strategy, weights)
+}
+
+// AllocateByPercentStrings splits money by percentages parsed exactly with
+// ParsePercent, such as "33.3%", "12.5" or "2500bp", with the given strategy; see
+// AllocateByPercentWith. Unlike AllocateByPercentage no float64 is involved, so the
+// result is the same on every platform.
+// Returns an error wrapping ErrInvalidAmount if a percentage is malformed and one
+// wrapping ErrDivisionByZero if every percentage is zero and m isn't zero.
+//
+// Example:
+//
+//	a, err := payment.AllocateByPercentStrings(LargestRemainder{}, "33.3%", "33.3%", "33.4%")
+func (m Money) AllocateByPercentStrings(strategy AllocationStrategy, ps ...string) (*Allocation, error) {
+	percents := make([]Percent, len(ps))
+	for i, s := range ps {
+		p, err := ParsePercent(s)
+		if err != nil {
+			return nil, err
+		}
+		percents[i] = p
+	}
+	return m.AllocateByPercentWith(strategy, percents...)
+}
+
+// AllocateByMoney splits money in proportion to other amounts of one currency, such
+// as a shipping fee pro-rated by line totals, with the given strategy; see
+// AllocateWith. The weights may be in another currency than m.
+// Returns ErrCurrencyMismatch if a weight is nil or the weights have different
+// currencies, an error for negative weights and an error wrapping ErrDivisionByZero
+// if every weight is zero and m isn't zero, such as shipping on free lines only.
+//
+// Example:
+//
+//	perLine, err := shipping.AllocateByMoney(LargestRemainder{}, line1, line2, line3)
+func (m Money) AllocateByMoney(strategy AllocationStrategy, weights ...*Money) (*Allocation, error) {
+	if len(weights) == 0 {
+		return nil, errors.New("no weights specified")
+	}
+
+	ws := make([]*big.Int, len(weights))
+	for i, w := range weights {
+		if w == nil || w.currency == nil || !w.currency.sameAs(weights[0].currency) {
+			return nil, ErrCurrencyMismatch
+		}
+		if w.amount < 0 {
+			return nil, errors.New("negative weights not allowed")
+		}
+		ws[i] = big.NewInt(w.amount)
+	}
+	return m.allocate(strategy, ws)
+}
+
+// AllocateInIncrements splits money by given ratios like AllocateWith, but every
+// part is a multiple of increment, given in minor units: 5 splits CHF in multiples of
+// 0.05. Only the largest multiple of increment toward zero is split; the difference
+// from m, smaller than one increment and of the sign of m, is returned separately.
+// Extra counts leftover minor units, in whole increments.
+// Returns ErrInvalidAmount if increment isn't positive.
+//
+// Example:
+//
+//	bill, _ := NewFromString("100.03", CHF)
+//	const fiveRappen = 5 // the increment, followed by three equal ratios
+//	a, rest, err := bill.AllocateInIncrements(LargestRemainder{}, fiveRappen, 1, 1, 1)
+//	// a.Parts: 33.35, 33.35, 33.30 CHF, rest: 0.03 CHF
+func (m Money) AllocateInIncrements(strategy AllocationStrategy, increment int64, rs ...int) (*Allocation, *Money, error) {
+	if increment <= 0 {
+		return nil, nil, ErrInvalidAmount
+	}
+
+	steps := Money{amount: m.amount / increment, currency: m.currency}
+	a, err := steps.AllocateWith(strategy, rs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, part := range a.Parts {
+		part.amount *= increment
+		a.Extra[i] *= increment
+	}
+	return a, &Money{amount: m.amount % increment, currency: m.currency}, nil
+}
+
+// AllocateCash splits money by given ratios into amounts payable in cash, multiples
+// of the smallest coin of its currency, see AllocateInIncrements and CashRoundingMap.
+//
+// Example:
+//
+//	bill, _ := NewFromString("100.00", CHF)
+//	a, rest, err := bill.AllocateCash(LargestRemainder{}, 1, 1, 1)
+//	// a.Parts: 33.35, 33.35, 33.30 CHF, rest: 0.00 CHF
+func (m Money) AllocateCash(strategy AllocationStrategy, rs ...int) (*Allocation, *Money, error) {
+	return m.AllocateInIncrements(strategy, m.cashIncrement(), rs...)
+}
+
+// allocate splits m in proportion to non-negative weights. A zero total weight
+// allocates zero to every party if m is zero.
+// Returns an error wrapping ErrDivisionByZero if the weights sum to zero and m isn't
+// zero, as the amount couldn't be split without losing it.
+func (m Money) allocate(strategy AllocationStrategy, weights []*big.Int) (*Allocation, error) {
+	if strategy == nil {
+		strategy = RoundRobin{}
+	}
+
+	total := new(big.Int)
+	for _, w := range weights {
+		total.Add(total, w)
+	}
+
+	a := &Allocation{
+		Parts: make([]*Money, len(weights)),
+		Extra: make([]int64, len(weights)),
+	}
+	if total.Sign() == 0 {
+		if m.amount != 0 {
+			return nil, fmt.Errorf("%w: weights sum to zero", ErrDivisionByZero)
+		}
+		for i := range a.Parts {
+			a.Parts[i] = &Money{amount: 0, currency: m.currency}
+		}
+		return a, nil
+	}
+
+	// split the magnitude so every quota rounds toward zero
+	magnitude := new(big.Int).Abs(big.NewInt(m.amount))
+	leftover := new(big.Int).Set(magnitude)
+	quotas := make([]*big.Int, len(weights))
+	shares := make([]allocationShare, len(weights))
+	for i, w := range weights {
+		quotas[i], shares[i].remainder = new(big.Int).DivMod(new(big.Int).Mul(magnitude, w), total, new(big.Int))
+		shares[i].weight = w
+		leftover.Sub(leftover, quotas[i])
+	}
+
+	// the leftover is below the number of parties
+	extra := strategy.distribute(shares, leftover.Int64())
+	for i, quota := range quotas {
+		units := quota.Add(quota, big.NewInt(extra[i]))
+		if m.amount < 0 {
+			units.Neg(units)
+			extra[i] = -extra[i]
+		}
+		a.Parts[i] = &Money{amount: units.Int64(), currency: m.currency}
+	}
+	a.Extra = extra
+	return a, nil
+}
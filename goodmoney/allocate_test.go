@@ -0,0 +1,290 @@
+package goodmoney
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAllocateWith(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		units     int64
+		strategy  AllocationStrategy
+		ratios    []int
+		want      []int64
+		receivers []int
+	}{
+		{name: "round robin", units: 100, strategy: RoundRobin{}, ratios: []int{1, 1, 1}, want: []int64{34, 33, 33}, receivers: []int{0}},
+		{name: "nil is round robin", units: 100, ratios: []int{1, 1, 1}, want: []int64{34, 33, 33}, receivers: []int{0}},
+		{name: "round robin skips zero ratios", units: 5, strategy: RoundRobin{}, ratios: []int{0, 1, 1, 1}, want: []int64{0, 2, 2, 1}, receivers: []int{1, 2}},
+		{name: "largest remainder", units: 100, strategy: LargestRemainder{}, ratios: []int{1, 1, 4}, want: []int64{17, 17, 66}, receivers: []int{0, 1}},
+		{name: "largest remainder unlike round robin", units: 100, strategy: LargestRemainder{}, ratios: []int{1, 1, 5}, want: []int64{14, 14, 72}, receivers: []int{2}},
+		{name: "round robin on the same split", units: 100, strategy: RoundRobin{}, ratios: []int{1, 1, 5}, want: []int64{15, 14, 71}, receivers: []int{0}},
+		{name: "largest remainder ties go first", units: 2, strategy: LargestRemainder{}, ratios: []int{1, 1, 1}, want: []int64{1, 1, 0}, receivers: []int{0, 1}},
+		{name: "remainder to last", units: 100, strategy: RemainderToLast{}, ratios: []int{1, 1, 1}, want: []int64{33, 33, 34}, receivers: []int{2}},
+		{name: "remainder to last positive ratio", units: 5, strategy: RemainderToLast{}, ratios: []int{1, 1, 1, 0}, want: []int64{1, 1, 3, 0}, receivers: []int{2}},
+		{name: "remainder to largest share", units: 1000, strategy: RemainderToLargestShare{}, ratios: []int{10, 13, 7}, want: []int64{333, 434, 233}, receivers: []int{1}},
+		{name: "remainder to largest share takes several", units: 5, strategy: RemainderToLargestShare{}, ratios: []int{1, 2, 1, 2}, want: []int64{0, 4, 0, 1}, receivers: []int{1}},
+		{name: "negative amount", units: -100, strategy: RemainderToLast{}, ratios: []int{1, 1, 1}, want: []int64{-33, -33, -34}, receivers: []int{2}},
+		{name: "no leftover", units: 90, strategy: LargestRemainder{}, ratios: []int{1, 2}, want: []int64{30, 60}},
+		{name: "zero ratios of zero", units: 0, strategy: LargestRemainder{}, ratios: []int{0, 0}, want: []int64{0, 0}},
+		{name: "exact on extreme amounts", units: math.MinInt64, strategy: LargestRemainder{}, ratios: []int{1, 2}, want: []int64{-3074457345618258603, -6148914691236517205}, receivers: []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mustMinorUnits(tt.units, USD).AllocateWith(tt.strategy, tt.ratios...)
+			if err != nil {
+				t.Fatalf("AllocateWith() unexpected error: %v", err)
+			}
+			units := make([]int64, len(got.Parts))
+			for i, part := range got.Parts {
+				units[i] = part.MinorUnits()
+			}
+			if !reflect.DeepEqual(units, tt.want) {
+				t.Errorf("AllocateWith() = %v, want %v", units, tt.want)
+			}
+			if receivers := got.Receivers(); !reflect.DeepEqual(receivers, tt.receivers) {
+				t.Errorf("Receivers() = %v, want %v", receivers, tt.receivers)
+			}
+		})
+	}
+}
+
+func TestAllocateWithErrors(t *testing.T) {
+	t.Parallel()
+
+	m := mustMinorUnits(100, USD)
+	if _, err := m.AllocateWith(LargestRemainder{}); err == nil {
+		t.Error("AllocateWith() without ratios succeeded, want an error")
+	}
+	if _, err := m.AllocateWith(LargestRemainder{}, 1, -1); err == nil {
+		t.Error("AllocateWith() with a negative ratio succeeded, want an error")
+	}
+	if _, err := m.AllocateWith(LargestRemainder{}, 0, 0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("AllocateWith() with zero ratios error = %v, want %v", err, ErrDivisionByZero)
+	}
+	if _, err := m.AllocateByPercentWith(LargestRemainder{}); err == nil {
+		t.Error("AllocateByPercentWith() without percentages succeeded, want an error")
+	}
+	if _, err := m.AllocateByPercentWith(LargestRemainder{}, BasisPoints(-1).Percent()); err == nil {
+		t.Error("AllocateByPercentWith() with a negative percentage succeeded, want an error")
+	}
+}
+
+func TestAllocateByPercentWith(t *testing.T) {
+	t.Parallel()
+
+	third, _ := NewPercent(100, 3)
+	got, err := mustMinorUnits(10000, USD).AllocateByPercentWith(LargestRemainder{}, third, BasisPoints(1667).Percent(), BasisPoints(5000).Percent())
+	if err != nil {
+		t.Fatalf("AllocateByPercentWith() unexpected error: %v", err)
+	}
+	units := []int64{got.Parts[0].MinorUnits(), got.Parts[1].MinorUnits(), got.Parts[2].MinorUnits()}
+	// 10000 in proportion to 1/3, 0.1667 and 0.5 is 3333.266..., 1666.933... and 4999.800...
+	if want := []int64{3333, 1667, 5000}; !reflect.DeepEqual(units, want) {
+		t.Errorf("AllocateByPercentWith() = %v, want %v", units, want)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got.Receivers(), want) {
+		t.Errorf("Receivers() = %v, want %v", got.Receivers(), want)
+	}
+}
+
+func TestSeededRandom(t *testing.T) {
+	t.Parallel()
+
+	m := mustMinorUnits(1000, USD)
+	ratios := []int{1, 1, 1, 1, 1, 1, 1}
+
+	first, _ := m.AllocateWith(SeededRandom{Seed: 42}, ratios...)
+	again, _ := m.AllocateWith(SeededRandom{Seed: 42}, ratios...)
+	if !reflect.DeepEqual(first.Extra, again.Extra) {
+		t.Errorf("SeededRandom isn't deterministic: %v, then %v", first.Extra, again.Extra)
+	}
+	// 1000 in 7 leaves 6 units for 7 parties
+	if len(first.Receivers()) != 6 {
+		t.Errorf("Receivers() = %v, want 6 parties", first.Receivers())
+	}
+
+	seen := map[int]bool{}
+	for seed := uint64(0); seed < 50; seed++ {
+		a, _ := m.AllocateWith(SeededRandom{Seed: seed}, ratios...)
+		for i, extra := range a.Extra {
+			if extra == 0 {
+				seen[i] = true
+			}
+		}
+	}
+	if len(seen) != len(ratios) {
+		t.Errorf("parties left out across seeds = %v, want every party", seen)
+	}
+}
+
+func TestAllocateByPercentStrings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		units    int64
+		percents []string
+		want     []int64
+		wantErr  error
+	}{
+		{name: "thirds", units: 10000, percents: []string{"33.3%", "33.3%", "33.4%"}, want: []int64{3330, 3330, 3340}},
+		{name: "basis points and bare numbers", units: 999, percents: []string{"2500bp", "75"}, want: []int64{250, 749}},
+		{name: "not adding up to 100", units: 100, percents: []string{"10%", "20%"}, want: []int64{33, 67}},
+		{name: "large amount stays exact", units: 9007199254740993, percents: []string{"50%", "50%"}, want: []int64{4503599627370497, 4503599627370496}},
+		{name: "malformed", units: 100, percents: []string{"10%", "ten%"}, wantErr: ErrInvalidAmount},
+		{name: "all zero", units: 100, percents: []string{"0%", "0%"}, wantErr: ErrDivisionByZero},
+		{name: "all zero of zero", units: 0, percents: []string{"0%", "0%"}, want: []int64{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mustMinorUnits(tt.units, USD).AllocateByPercentStrings(LargestRemainder{}, tt.percents...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AllocateByPercentStrings() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			units := make([]int64, len(got.Parts))
+			for i, part := range got.Parts {
+				units[i] = part.MinorUnits()
+			}
+			if !reflect.DeepEqual(units, tt.want) {
+				t.Errorf("AllocateByPercentStrings() = %v, want %v", units, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllocateByMoney(t *testing.T) {
+	t.Parallel()
+
+	shipping := mustMinorUnits(1000, USD)
+	lines := []*Money{mustMinorUnits(1999, EUR), mustMinorUnits(4550, EUR), mustMinorUnits(0, EUR), mustMinorUnits(1251, EUR)}
+
+	got, err := shipping.AllocateByMoney(LargestRemainder{}, lines...)
+	if err != nil {
+		t.Fatalf("AllocateByMoney() unexpected error: %v", err)
+	}
+	// 1000 in proportion to 1999, 4550, 0 and 1251 out of 7800 is 256.28, 583.33, 0 and 160.38
+	units := []int64{got.Parts[0].MinorUnits(), got.Parts[1].MinorUnits(), got.Parts[2].MinorUnits(), got.Parts[3].MinorUnits()}
+	if want := []int64{256, 583, 0, 161}; !reflect.DeepEqual(units, want) {
+		t.Errorf("AllocateByMoney() = %v, want %v", units, want)
+	}
+	if got.Parts[0].Currency() != USD {
+		t.Errorf("AllocateByMoney() currency = %s, want %s", got.Parts[0].Currency(), USD)
+	}
+
+	if _, err := shipping.AllocateByMoney(LargestRemainder{}, lines[0], mustMinorUnits(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("AllocateByMoney() mixed currencies error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := shipping.AllocateByMoney(LargestRemainder{}, lines[0], nil); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("AllocateByMoney(nil) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := shipping.AllocateByMoney(LargestRemainder{}, mustMinorUnits(-1, EUR)); err == nil {
+		t.Error("AllocateByMoney() with a negative weight succeeded, want an error")
+	}
+	if _, err := shipping.AllocateByMoney(LargestRemainder{}); err == nil {
+		t.Error("AllocateByMoney() without weights succeeded, want an error")
+	}
+
+	// shipping on an order of free lines only can't be pro-rated
+	free := []*Money{mustMinorUnits(0, EUR), mustMinorUnits(0, EUR)}
+	if got, err := shipping.AllocateByMoney(LargestRemainder{}, free...); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("AllocateByMoney() with zero line totals = %v, %v, want error %v", got, err, ErrDivisionByZero)
+	}
+}
+
+func TestAllocateInIncrements(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		amount    string
+		code      string
+		increment int64
+		ratios    []int
+		want      []string
+		wantRest  string
+		wantErr   error
+	}{
+		{name: "CHF in nickels", amount: "100.03", code: CHF, increment: 5, ratios: []int{1, 1, 1}, want: []string{"33.35", "33.35", "33.30"}, wantRest: "0.03"},
+		{name: "exact multiple", amount: "10.00", code: CHF, increment: 5, ratios: []int{1, 3}, want: []string{"2.50", "7.50"}, wantRest: "0.00"},
+		{name: "whole units", amount: "-99.99", code: USD, increment: 100, ratios: []int{1, 1}, want: []string{"-50.00", "-49.00"}, wantRest: "-0.99"},
+		{name: "increment of one", amount: "1.00", code: USD, increment: 1, ratios: []int{1, 2}, want: []string{"0.33", "0.67"}, wantRest: "0.00"},
+		{name: "zero increment", amount: "1.00", code: USD, increment: 0, ratios: []int{1}, wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewFromString(tt.amount, tt.code)
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error: %v", err)
+			}
+			got, rest, err := m.AllocateInIncrements(LargestRemainder{}, tt.increment, tt.ratios...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AllocateInIncrements() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			parts := make([]string, len(got.Parts))
+			for i, part := range got.Parts {
+				parts[i] = part.DecimalString()
+			}
+			if !reflect.DeepEqual(parts, tt.want) || rest.DecimalString() != tt.wantRest {
+				t.Errorf("AllocateInIncrements() = %v, %s, want %v, %s", parts, rest.DecimalString(), tt.want, tt.wantRest)
+			}
+		})
+	}
+}
+
+func TestAllocateCash(t *testing.T) {
+	t.Parallel()
+
+	m, _ := NewFromString("100.00", CHF)
+	got, rest, err := m.AllocateCash(LargestRemainder{}, 1, 1, 1)
+	if err != nil {
+		t.Fatalf("AllocateCash() unexpected error: %v", err)
+	}
+	for _, part := range got.Parts {
+		if !part.IsCashRounded() {
+			t.Errorf("AllocateCash() part %s isn't cash rounded", part)
+		}
+	}
+	if !rest.IsZero() || !reflect.DeepEqual(got.Extra, []int64{5, 5, 0}) {
+		t.Errorf("AllocateCash() = %v extra %v, rest %s, want extra [5 5 0], rest 0", got.Parts, got.Extra, rest)
+	}
+
+	// SEK is paid in whole kronor
+	m, _ = NewFromString("100.50", SEK)
+	got, rest, _ = m.AllocateCash(RemainderToLast{}, 1, 1)
+	if got.Parts[0].DecimalString() != "50.00" || got.Parts[1].DecimalString() != "50.00" || rest.DecimalString() != "0.50" {
+		t.Errorf("AllocateCash(SEK) = %v, %s, want 50.00, 50.00 and 0.50", got.Parts, rest)
+	}
+}
+
+func ExampleMoney_AllocateInIncrements() {
+	bill, _ := NewFromString("100.03", CHF)
+	const fiveRappen = 5 // the increment, followed by three equal ratios
+	a, rest, err := bill.AllocateInIncrements(LargestRemainder{}, fiveRappen, 1, 1, 1)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(a.Parts, rest)
+	// Output: [33.35 CHF 33.35 CHF 33.30 CHF] 0.03 CHF
+}
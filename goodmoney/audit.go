@@ -0,0 +1,209 @@
+package goodmoney
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// ConversionRecord is an immutable record of how a conversion was computed, for audit
+// trails: the source and converted Money, the rate with its source and time, the
+// rounding scheme, the residual the rounding left out and when the conversion was made.
+//
+// It serializes to JSON with Money in the format of Money.MarshalJSON, and exact
+// numbers as strings: decimals when they terminate ("57.3122"), fractions otherwise ("1/3").
+//
+//	{
+//	  "source": {"amount": 100, "currency": "USD"},
+//	  "target": {"amount": 5731.22, "currency": "ETB"},
+//	  "rate": "57.3122",
+//	  "rate_source": "ecb",
+//	  "rate_time": "2025-03-31T00:00:00Z",
+//	  "inverted": false,
+//	  "round_scheme": "HalfEven",
+//	  "residual": "0",
+//	  "converted_at": "2025-03-31T14:02:11Z"
+//	}
+//
+// rate_time is omitted for rates without a time, such as those of a StaticRateProvider.
+type ConversionRecord struct {
+	source      Money
+	target      Money
+	rate        Rate
+	scheme      RoundScheme
+	residual    *big.Rat
+	convertedAt time.Time
+}
+
+// Source returns the converted Money.
+func (r *ConversionRecord) Source() Money {
+	return r.source
+}
+
+// Target returns the result of the conversion.
+func (r *ConversionRecord) Target() Money {
+	return r.target
+}
+
+// Rate returns a copy of the rate used. It is a rate of 1 without a Source when
+// the source was already in the target currency.
+func (r *ConversionRecord) Rate() Rate {
+	rate := r.rate
+	rate.Value = new(big.Rat).Set(r.rate.Value)
+	rate.Path = append([]string(nil), r.rate.Path...)
+	return rate
+}
+
+// RoundScheme returns the rounding scheme applied to the converted amount.
+func (r *ConversionRecord) RoundScheme() RoundScheme {
+	return r.scheme
+}
+
+// Residual returns the exact converted amount minus Target, in major units of the
+// target currency: positive when the rounding went down, negative when it went up.
+func (r *ConversionRecord) Residual() *big.Rat {
+	return new(big.Rat).Set(r.residual)
+}
+
+// ConvertedAt returns when the conversion was made, from the converter's clock.
+func (r *ConversionRecord) ConvertedAt() time.Time {
+	return r.convertedAt
+}
+
+// ConvertWithRecord converts m into the currency to at the rate valid at the given time,
+// like ConvertAt, and also returns the record of the conversion.
+//
+// Example:
+//
+//	etb, record, err := converter.ConvertWithRecord(ctx, usd, ETB, time.Time{})
+//	entry, _ := json.Marshal(record)
+func (c *Converter) ConvertWithRecord(ctx context.Context, m *Money, to string, at time.Time) (*Money, *ConversionRecord, error) {
+	converted, rate, err := c.convert(ctx, m, to, at)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	record := &ConversionRecord{
+		source:      *m,
+		target:      *converted,
+		scheme:      c.scheme,
+		residual:    new(big.Rat),
+		convertedAt: c.now(),
+	}
+	if rate == nil {
+		record.rate = Rate{Base: m.currency.code, Quote: to, Value: big.NewRat(1, 1)}
+		return converted, record, nil
+	}
+
+	record.rate = *rate
+	record.rate.Value = new(big.Rat).Set(rate.Value)
+	record.rate.Path = append([]string(nil), rate.Path...)
+
+	exact := exactConversion(m.amount, m.currency, converted.currency, rate.Value)
+	record.residual.Sub(exact, new(big.Rat).SetInt64(converted.amount))
+	record.residual.Quo(record.residual, new(big.Rat).SetInt(bigPow10(converted.scale())))
+	return converted, record, nil
+}
+
+// conversionRecordJSON represents the JSON structure for ConversionRecord serialization
+type conversionRecordJSON struct {
+	Source      Money     `json:"source"`
+	Target      Money     `json:"target"`
+	Rate        string    `json:"rate"`
+	RateSource  string    `json:"rate_source"`
+	RateTime    time.Time `json:"rate_time,omitzero"`
+	Inverted    bool      `json:"inverted"`
+	Path        []string  `json:"path,omitempty"`
+	RoundScheme string    `json:"round_scheme"`
+	Residual    string    `json:"residual"`
+	ConvertedAt time.Time `json:"converted_at"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (r ConversionRecord) MarshalJSON() ([]byte, error) {
+	if r.rate.Value == nil || r.residual == nil {
+		return nil, errors.New("cannot marshal an empty ConversionRecord")
+	}
+
+	return json.Marshal(conversionRecordJSON{
+		Source:      r.source,
+		Target:      r.target,
+		Rate:        ratString(r.rate.Value),
+		RateSource:  r.rate.Source,
+		RateTime:    r.rate.Time,
+		Inverted:    r.rate.Inverted,
+		Path:        r.rate.Path,
+		RoundScheme: r.scheme.String(),
+		Residual:    ratString(r.residual),
+		ConvertedAt: r.convertedAt,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, reading records written by MarshalJSON.
+func (r *ConversionRecord) UnmarshalJSON(data []byte) error {
+	var j conversionRecordJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return fmt.Errorf("failed to unmarshal ConversionRecord: %w", err)
+	}
+
+	rate, ok := new(big.Rat).SetString(j.Rate)
+	if !ok || rate.Sign() <= 0 {
+		return fmt.Errorf("%w: %q", ErrInvalidRate, j.Rate)
+	}
+	residual, ok := new(big.Rat).SetString(j.Residual)
+	if !ok {
+		return fmt.Errorf("%w: residual %q", ErrInvalidAmount, j.Residual)
+	}
+	scheme, ok := parseRoundScheme(j.RoundScheme)
+	if !ok {
+		return fmt.Errorf("unknown round scheme %q", j.RoundScheme)
+	}
+
+	*r = ConversionRecord{
+		source: j.Source,
+		target: j.Target,
+		rate: Rate{
+			Base:     j.Source.Currency(),
+			Quote:    j.Target.Currency(),
+			Value:    rate,
+			Time:     j.RateTime,
+			Source:   j.RateSource,
+			Inverted: j.Inverted,
+			Path:     j.Path,
+		},
+		scheme:      scheme,
+		residual:    residual,
+		convertedAt: j.ConvertedAt,
+	}
+	return nil
+}
+
+// ratString formats r exactly: as a decimal when it terminates, as a fraction otherwise.
+func ratString(r *big.Rat) string {
+	// a fraction terminates when its denominator only has factors 2 and 5
+	den := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	twos, fives := 0, 0
+	remainder := new(big.Int)
+	for {
+		q, m := new(big.Int).QuoRem(den, two, remainder)
+		if m.Sign() != 0 {
+			break
+		}
+		den, twos = q, twos+1
+	}
+	for {
+		q, m := new(big.Int).QuoRem(den, five, remainder)
+		if m.Sign() != 0 {
+			break
+		}
+		den, fives = q, fives+1
+	}
+	if den.Cmp(big.NewInt(1)) != 0 {
+		return r.RatString()
+	}
+	return r.FloatString(max(twos, fives))
+}
@@ -0,0 +1,153 @@
+package goodmoney
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestConvertWithRecord(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rates := NewStaticRateProvider()
+	_ = rates.SetRateString(USD, ETB, "57.3122")
+	_ = rates.SetRateString(EUR, USD, "1/3")
+	converter := NewConverter(rates, RoundHalfEven)
+
+	tests := []struct {
+		name         string
+		amount       *Money
+		to           string
+		wantTarget   string
+		wantRate     string
+		wantInv      bool
+		wantResidual *big.Rat
+	}{
+		{name: "exact", amount: mustMinorUnits(10000, USD), to: ETB, wantTarget: "5731.22 ETB", wantRate: "57.3122", wantResidual: new(big.Rat)},
+		{name: "rounded down", amount: mustMinorUnits(1, USD), to: ETB, wantTarget: "0.57 ETB", wantRate: "57.3122", wantResidual: big.NewRat(3122, 1000000)},
+		{name: "inverse", amount: mustMinorUnits(100, USD), to: EUR, wantTarget: "3.00 EUR", wantRate: "3", wantInv: true, wantResidual: new(big.Rat)},
+		{name: "repeating rate", amount: mustMinorUnits(100, EUR), to: USD, wantTarget: "0.33 USD", wantRate: "1/3", wantResidual: big.NewRat(1, 300)},
+		{name: "rounded up", amount: mustMinorUnits(200, EUR), to: USD, wantTarget: "0.67 USD", wantRate: "1/3", wantResidual: big.NewRat(-1, 300)},
+		{name: "same currency", amount: mustMinorUnits(100, EUR), to: EUR, wantTarget: "1.00 EUR", wantRate: "1", wantResidual: new(big.Rat)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			converted, record, err := converter.ConvertWithRecord(ctx, tt.amount, tt.to, time.Time{})
+			if err != nil {
+				t.Fatalf("ConvertWithRecord() unexpected error: %v", err)
+			}
+			if converted.String() != tt.wantTarget || record.Target() != *converted {
+				t.Errorf("ConvertWithRecord() = %s, record target %s, want %s", converted, record.Target(), tt.wantTarget)
+			}
+			if record.Source() != *tt.amount {
+				t.Errorf("Source() = %s, want %s", record.Source(), tt.amount)
+			}
+			if got := ratString(record.Rate().Value); got != tt.wantRate || record.Rate().Inverted != tt.wantInv {
+				t.Errorf("Rate() = %s inverted %v, want %s inverted %v", got, record.Rate().Inverted, tt.wantRate, tt.wantInv)
+			}
+			if record.RoundScheme() != RoundHalfEven {
+				t.Errorf("RoundScheme() = %v, want %v", record.RoundScheme(), RoundHalfEven)
+			}
+			if record.Residual().Cmp(tt.wantResidual) != 0 {
+				t.Errorf("Residual() = %s, want %s", record.Residual().RatString(), tt.wantResidual.RatString())
+			}
+		})
+	}
+}
+
+func TestConversionRecordIsImmutable(t *testing.T) {
+	t.Parallel()
+
+	rates := NewStaticRateProvider()
+	_ = rates.SetRateString(USD, ETB, "57.3122")
+	_, record, err := NewConverter(rates, RoundHalfEven).ConvertWithRecord(context.Background(), mustMinorUnits(1, USD), ETB, time.Time{})
+	if err != nil {
+		t.Fatalf("ConvertWithRecord() unexpected error: %v", err)
+	}
+
+	record.Rate().Value.SetInt64(1)
+	record.Residual().SetInt64(1)
+	_ = rates.SetRateString(USD, ETB, "60")
+
+	if got := ratString(record.Rate().Value); got != "57.3122" {
+		t.Errorf("Rate() = %s after modifications, want 57.3122", got)
+	}
+	if got := ratString(record.Residual()); got != "0.003122" {
+		t.Errorf("Residual() = %s after modifications, want 0.003122", got)
+	}
+}
+
+func TestConversionRecordJSON(t *testing.T) {
+	t.Parallel()
+
+	rates := NewStaticRateProvider()
+	_ = rates.SetRateString(EUR, USD, "1/3")
+	convertedAt := time.Date(2025, 3, 31, 14, 2, 11, 0, time.UTC)
+	converter := NewConverter(rates, RoundHalfUp).WithClock(func() time.Time { return convertedAt })
+	_, record, err := converter.ConvertWithRecord(context.Background(), mustMinorUnits(100, USD), EUR, time.Time{})
+	if err != nil {
+		t.Fatalf("ConvertWithRecord() unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	want := `{"source":{"amount":1,"currency":"USD"},"target":{"amount":3,"currency":"EUR"},"rate":"3","rate_source":"static","inverted":true,"round_scheme":"HalfUp","residual":"0","converted_at":"2025-03-31T14:02:11Z"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var decoded ConversionRecord
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+	if decoded.Source() != record.Source() || decoded.Target() != record.Target() || decoded.RoundScheme() != RoundHalfUp ||
+		decoded.Rate().Value.Cmp(big.NewRat(3, 1)) != 0 || !decoded.Rate().Inverted || decoded.Rate().Base != USD || decoded.Rate().Quote != EUR ||
+		!decoded.ConvertedAt().Equal(convertedAt) {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", decoded, *record)
+	}
+
+	invalid := []string{
+		`{"source":{"amount":1,"currency":"USD"},"target":{"amount":3,"currency":"EUR"},"rate":"0","round_scheme":"HalfUp","residual":"0"}`,
+		`{"source":{"amount":1,"currency":"USD"},"target":{"amount":3,"currency":"EUR"},"rate":"3","round_scheme":"Bankers","residual":"0"}`,
+		`{"source":{"amount":1,"currency":"USD"},"target":{"amount":3,"currency":"EUR"},"rate":"3","round_scheme":"HalfUp","residual":"x"}`,
+		`{"source":{"amount":1,"currency":"XYZ"}}`,
+	}
+	for _, input := range invalid {
+		if err := json.Unmarshal([]byte(input), &decoded); err == nil {
+			t.Errorf("json.Unmarshal(%s) should fail", input)
+		}
+	}
+
+	if _, err := json.Marshal(ConversionRecord{}); err == nil {
+		t.Error("json.Marshal() of an empty record should fail")
+	}
+}
+
+func TestRatString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value *big.Rat
+		want  string
+	}{
+		{value: big.NewRat(573122, 10000), want: "57.3122"},
+		{value: big.NewRat(-1, 8), want: "-0.125"},
+		{value: big.NewRat(3, 1), want: "3"},
+		{value: big.NewRat(1, 3), want: "1/3"},
+		{value: big.NewRat(1, 30), want: "1/30"},
+	}
+
+	for _, tt := range tests {
+		if got := ratString(tt.value); got != tt.want {
+			t.Errorf("ratString(%s) = %q, want %q", tt.value.RatString(), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package goodmoney
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MoneyConverter converts Money into another currency. Converter implements it.
+type MoneyConverter interface {
+	Convert(ctx context.Context, m *Money, to string) (*Money, error)
+}
+
+// Bag holds amounts in any number of currencies, such as the balances of a wallet.
+// Amounts of the same currency are summed, currencies whose balance comes back to
+// zero are dropped. Operations either apply completely or, on error, not at all.
+//
+// The zero Bag is empty and ready to use. A Bag is not safe for concurrent use.
+//
+// Example:
+//
+//	var wallet Bag
+//	_ = wallet.Add(usd, etb, moreUSD)
+//	for _, m := range wallet.Holdings() {
+//	    fmt.Println(m) // 10.00 ETB, then 25.50 USD
+//	}
+type Bag struct {
+	// holdings are keyed by currency code, which identifies a currency even across
+	// edits of CurrencyMap
+	holdings map[string]Money
+}
+
+// NewBag returns a Bag holding ms.
+func NewBag(ms ...*Money) (*Bag, error) {
+	b := &Bag{}
+	if err := b.Add(ms...); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Add adds ms to the bag. Returns ErrCurrencyMismatch for a nil Money or a Money
+// without currency, and ErrOverflow or ErrUnderflow when a balance would overflow.
+func (b *Bag) Add(ms ...*Money) error {
+	return b.apply(ms, addInt64)
+}
+
+// Subtract subtracts ms from the bag. Balances may become negative.
+func (b *Bag) Subtract(ms ...*Money) error {
+	return b.apply(ms, subtractInt64)
+}
+
+// apply combines each of ms into the balance of its currency with op.
+func (b *Bag) apply(ms []*Money, op func(a, b int64) (int64, error)) error {
+	// compute the new balances first so that a failure leaves the bag untouched
+	updated := make(map[string]Money, len(ms))
+	for _, m := range ms {
+		if m == nil || m.currency == nil {
+			return ErrCurrencyMismatch
+		}
+		balance, ok := updated[m.currency.code]
+		if !ok {
+			if balance, ok = b.holdings[m.currency.code]; !ok {
+				balance = Money{currency: m.currency}
+			}
+		}
+		amount, err := op(balance.amount, m.amount)
+		if err != nil {
+			return err
+		}
+		updated[m.currency.code] = Money{amount: amount, currency: balance.currency}
+	}
+
+	b.store(updated)
+	return nil
+}
+
+// store sets the balances of updated, dropping those that are zero.
+func (b *Bag) store(updated map[string]Money) {
+	if b.holdings == nil {
+		b.holdings = make(map[string]Money, len(updated))
+	}
+	for code, balance := range updated {
+		if balance.amount == 0 {
+			delete(b.holdings, code)
+		} else {
+			b.holdings[code] = balance
+		}
+	}
+}
+
+// Negate negates every balance of the bag.
+// Returns ErrOverflow if a balance is the minimum int64.
+func (b *Bag) Negate() error {
+	updated := make(map[string]Money, len(b.holdings))
+	for code, balance := range b.holdings {
+		negated, err := subtractInt64(0, balance.amount)
+		if err != nil {
+			return err
+		}
+		updated[code] = Money{amount: negated, currency: balance.currency}
+	}
+
+	b.store(updated)
+	return nil
+}
+
+// Merge adds every balance of other to the bag.
+func (b *Bag) Merge(other *Bag) error {
+	if other == nil {
+		return nil
+	}
+	return b.Add(other.Holdings()...)
+}
+
+// Clone returns a copy of the bag.
+func (b *Bag) Clone() *Bag {
+	clone := &Bag{}
+	if len(b.holdings) > 0 {
+		clone.holdings = make(map[string]Money, len(b.holdings))
+		for code, balance := range b.holdings {
+			clone.holdings[code] = balance
+		}
+	}
+	return clone
+}
+
+// Get returns the balance of a currency, zero if the bag doesn't hold it.
+// Returns ErrCurrencyCodeDoesNotExist for unknown currencies.
+func (b *Bag) Get(currencyCode string) (*Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	return &Money{amount: b.holdings[currencyCode].amount, currency: c}, nil
+}
+
+// Len returns the number of currencies with a non-zero balance.
+func (b *Bag) Len() int {
+	return len(b.holdings)
+}
+
+// IsZero returns true if every balance is zero.
+func (b *Bag) IsZero() bool {
+	return len(b.holdings) == 0
+}
+
+// Holdings returns the non-zero balances sorted by currency code.
+func (b *Bag) Holdings() []*Money {
+	holdings := make([]*Money, 0, len(b.holdings))
+	for _, balance := range b.holdings {
+		holdings = append(holdings, &Money{amount: balance.amount, currency: balance.currency})
+	}
+	slices.SortFunc(holdings, func(x, y *Money) int {
+		return strings.Compare(x.currency.code, y.currency.code)
+	})
+	return holdings
+}
+
+// Total converts every balance into the currency to with converter and sums them.
+// Each balance is converted and rounded on its own, in currency code order.
+//
+// Example:
+//
+//	total, err := wallet.Total(ctx, converter, USD)
+func (b *Bag) Total(ctx context.Context, converter MoneyConverter, to string) (*Money, error) {
+	target, err := lookupCurrency(to)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &Money{currency: target}
+	for _, m := range b.Holdings() {
+		converted, err := converter.Convert(ctx, m, to)
+		if err != nil {
+			return nil, fmt.Errorf("converting %s: %w", m.currency.code, err)
+		}
+		if !converted.currency.sameAs(target) {
+			return nil, ErrCurrencyMismatch
+		}
+		if total.amount, err = addInt64(total.amount, converted.amount); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+// String returns the holdings separated by commas, e.g. "10.00 ETB, 25.50 USD".
+func (b *Bag) String() string {
+	holdings := b.Holdings()
+	s := make([]string, len(holdings))
+	for i, m := range holdings {
+		s[i] = m.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// It serializes the holdings sorted by currency code, each in the format of Money:
+// [{"amount": 10, "currency": "ETB"}, {"amount": 25.5, "currency": "USD"}]
+func (b Bag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Holdings())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// It replaces the holdings, summing entries of the same currency.
+func (b *Bag) UnmarshalJSON(data []byte) error {
+	var holdings []*Money
+	if err := json.Unmarshal(data, &holdings); err != nil {
+		return fmt.Errorf("failed to unmarshal Bag: %w", err)
+	}
+
+	var decoded Bag
+	if err := decoded.Add(holdings...); err != nil {
+		return fmt.Errorf("failed to unmarshal Bag: %w", err)
+	}
+	*b = decoded
+	return nil
+}
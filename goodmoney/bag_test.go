@@ -0,0 +1,196 @@
+package goodmoney
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBag(t *testing.T) {
+	t.Parallel()
+
+	var b Bag
+	if !b.IsZero() || b.String() != "" {
+		t.Fatalf("zero Bag = %q, want empty", b.String())
+	}
+
+	steps := []struct {
+		name    string
+		op      func(b *Bag) error
+		want    string
+		wantErr error
+	}{
+		{name: "add", op: func(b *Bag) error {
+			return b.Add(mustMinorUnits(1050, USD), mustMinorUnits(1000, ETB), mustMinorUnits(1500, USD))
+		}, want: "10.00 ETB, 25.50 USD"},
+		{name: "subtract", op: func(b *Bag) error {
+			return b.Subtract(mustMinorUnits(550, USD), mustMinorUnits(300, JPY))
+		}, want: "10.00 ETB, -300 JPY, 20.00 USD"},
+		{name: "balance back to zero is dropped", op: func(b *Bag) error {
+			return b.Add(mustMinorUnits(300, JPY))
+		}, want: "10.00 ETB, 20.00 USD"},
+		{name: "negate", op: (*Bag).Negate, want: "-10.00 ETB, -20.00 USD"},
+		{name: "merge", op: func(b *Bag) error {
+			other, _ := NewBag(mustMinorUnits(2000, USD), mustMinorUnits(100, KES))
+			return b.Merge(other)
+		}, want: "-10.00 ETB, 1.00 KES"},
+		{name: "merge nil", op: func(b *Bag) error { return b.Merge(nil) }, want: "-10.00 ETB, 1.00 KES"},
+		{name: "overflow leaves the bag untouched", op: func(b *Bag) error {
+			return b.Add(mustMinorUnits(100, USD), mustMinorUnits(math.MaxInt64, KES))
+		}, want: "-10.00 ETB, 1.00 KES", wantErr: ErrOverflow},
+		{name: "nil money leaves the bag untouched", op: func(b *Bag) error {
+			return b.Add(mustMinorUnits(100, USD), nil)
+		}, want: "-10.00 ETB, 1.00 KES", wantErr: ErrCurrencyMismatch},
+		{name: "money without currency", op: func(b *Bag) error {
+			return b.Subtract(&Money{amount: 1})
+		}, want: "-10.00 ETB, 1.00 KES", wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, step := range steps {
+		if err := step.op(&b); !errors.Is(err, step.wantErr) {
+			t.Fatalf("%s: error = %v, want %v", step.name, err, step.wantErr)
+		}
+		if got := b.String(); got != step.want {
+			t.Errorf("%s: bag = %q, want %q", step.name, got, step.want)
+		}
+	}
+
+	if b.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", b.Len())
+	}
+	if m, err := b.Get(ETB); err != nil || m.MinorUnits() != -1000 {
+		t.Errorf("Get(ETB) = %v, %v, want -10.00 ETB", m, err)
+	}
+	if m, err := b.Get(USD); err != nil || !m.IsZero() || m.Currency() != USD {
+		t.Errorf("Get(USD) = %v, %v, want 0.00 USD", m, err)
+	}
+	if _, err := b.Get("XYZ"); !errors.Is(err, ErrCurrencyCodeDoesNotExist) {
+		t.Errorf("Get(XYZ) error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+}
+
+func TestBagNegateOverflow(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBag(mustMinorUnits(100, USD), mustMinorUnits(math.MinInt64, ETB))
+	if err := b.Negate(); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Negate() error = %v, want %v", err, ErrOverflow)
+	}
+	if m, _ := b.Get(USD); m.MinorUnits() != 100 {
+		t.Errorf("Negate() changed the bag to %s on error", b)
+	}
+}
+
+func TestBagClone(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBag(mustMinorUnits(100, USD))
+	clone := b.Clone()
+	_ = clone.Add(mustMinorUnits(100, USD))
+
+	if b.String() != "1.00 USD" || clone.String() != "2.00 USD" {
+		t.Errorf("bag = %s, clone = %s, want 1.00 USD and 2.00 USD", b, clone)
+	}
+}
+
+func TestBagTotal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rates := NewStaticRateProvider()
+	_ = rates.SetRateString(USD, ETB, "57.5")
+	_ = rates.SetRateString(EUR, ETB, "62.5")
+	converter := NewConverter(rates, RoundHalfEven)
+
+	b, _ := NewBag(mustMinorUnits(200, USD), mustMinorUnits(100, EUR), mustMinorUnits(-1000, ETB))
+
+	total, err := b.Total(ctx, converter, ETB)
+	if err != nil {
+		t.Fatalf("Total() unexpected error: %v", err)
+	}
+	if total.String() != "167.50 ETB" {
+		t.Errorf("Total() = %s, want 167.50 ETB", total)
+	}
+
+	var empty Bag
+	if total, err := empty.Total(ctx, converter, USD); err != nil || total.String() != "0.00 USD" {
+		t.Errorf("Total() of an empty bag = %v, %v, want 0.00 USD", total, err)
+	}
+
+	if _, err := b.Total(ctx, converter, KES); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Total() error = %v, want %v", err, ErrRateNotFound)
+	}
+	if _, err := b.Total(ctx, converter, "XYZ"); !errors.Is(err, ErrCurrencyCodeDoesNotExist) {
+		t.Errorf("Total() error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+}
+
+// wrongCurrencyConverter converts everything into USD, whatever was asked for.
+type wrongCurrencyConverter struct{}
+
+func (wrongCurrencyConverter) Convert(ctx context.Context, m *Money, to string) (*Money, error) {
+	return NewFromMinorUnits(m.MinorUnits(), USD)
+}
+
+func TestBagTotalChecksCurrency(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBag(mustMinorUnits(100, EUR))
+	if _, err := b.Total(context.Background(), wrongCurrencyConverter{}, ETB); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Total() error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+
+	// a caching converter is also a MoneyConverter
+	var _ MoneyConverter = NewConverter(NewCachingRateProvider(NewStaticRateProvider(), CacheOptions{TTL: time.Minute}), RoundHalfEven)
+}
+
+func TestBagJSON(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBag(mustMinorUnits(2550, USD), mustMinorUnits(1000, ETB))
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	want := `[{"amount":10,"currency":"ETB"},{"amount":25.5,"currency":"USD"}]`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var decoded Bag
+	if err := json.Unmarshal([]byte(`[{"amount":25.5,"currency":"USD"},{"amount":10,"currency":"ETB"},{"amount":1,"currency":"USD"}]`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+	if decoded.String() != "10.00 ETB, 26.50 USD" {
+		t.Errorf("json.Unmarshal() = %s, want 10.00 ETB, 26.50 USD", decoded.String())
+	}
+
+	// a Bag held by value, as in a struct field
+	type wallet struct {
+		Balances Bag
+	}
+	data, err = json.Marshal(wallet{Balances: *b})
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	if want := `{"Balances":` + want + `}`; string(data) != want {
+		t.Errorf("json.Marshal() of a struct field = %s, want %s", data, want)
+	}
+
+	var empty Bag
+	if data, _ := json.Marshal(&empty); string(data) != "[]" {
+		t.Errorf("json.Marshal() of an empty bag = %s, want []", data)
+	}
+
+	for _, input := range []string{`{}`, `[{"amount":1,"currency":"XYZ"}]`, `[null]`} {
+		if err := json.Unmarshal([]byte(input), &decoded); err == nil {
+			t.Errorf("json.Unmarshal(%s) should fail", input)
+		}
+	}
+	if decoded.String() != "10.00 ETB, 26.50 USD" {
+		t.Errorf("failed json.Unmarshal() changed the bag to %s", decoded.String())
+	}
+}
@@ -0,0 +1,527 @@
+package goodmoney
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// BigMoney is Money backed by an arbitrary-precision count of minor units, for
+// amounts beyond the ±9.2e18 minor units of int64 such as national budgets in IDR
+// or token balances with 18 decimals. It shares the currency registry, the format
+// modes and the JSON and SQL encodings of Money, and converts to and from Money
+// without loss when the amount fits.
+//
+// BigMoney values are immutable: operations return new values and accessors return
+// copies. The zero value is zero with no currency.
+type BigMoney struct {
+	amount   *big.Int
+	currency *currencyEntry
+}
+
+// NewBigFromString creates a new BigMoney from a decimal string such as
+// "123456789012345678901.50", with the same rules as NewFromString but no limit on
+// the magnitude.
+// Returns ErrInvalidAmount for malformed input and ErrTooManyDecimalPlaces if the
+// amount has more decimals than the currency's minor unit.
+//
+// Example:
+//
+//	budget, err := NewBigFromString("3325100000000000000000", IDR)
+func NewBigFromString(amount string, currencyCode string) (*BigMoney, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := parseBigDecimal(amount, c.MinorUnit)
+	if err != nil {
+		return nil, err
+	}
+	return &BigMoney{amount: units, currency: c}, nil
+}
+
+// NewBigFromMinorUnits creates a new BigMoney from an amount already expressed in
+// minor units. The units are copied.
+// Returns ErrInvalidAmount if units is nil.
+//
+// Example:
+//
+//	// with "ETH" registered in CurrencyMap with a MinorUnit of 18
+//	wei, _ := new(big.Int).SetString("1500000000000000000000", 10)
+//	balance, err := NewBigFromMinorUnits(wei, "ETH") // 1500 ETH
+func NewBigFromMinorUnits(units *big.Int, currencyCode string) (*BigMoney, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	if units == nil {
+		return nil, ErrInvalidAmount
+	}
+	return &BigMoney{amount: new(big.Int).Set(units), currency: c}, nil
+}
+
+// NewBigFromRat creates a new BigMoney from an exact rational amount in major units.
+// Returns ErrInvalidAmount if amount is nil and ErrTooManyDecimalPlaces if it isn't
+// a whole number of minor units.
+func NewBigFromRat(amount *big.Rat, currencyCode string) (*BigMoney, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	if amount == nil {
+		return nil, ErrInvalidAmount
+	}
+
+	units, err := ratToBigUnits(amount, c.MinorUnit)
+	if err != nil {
+		return nil, err
+	}
+	return &BigMoney{amount: units, currency: c}, nil
+}
+
+// ratToBigUnits returns amount in 10^-scale units, or ErrTooManyDecimalPlaces if it
+// isn't a whole number of them.
+func ratToBigUnits(amount *big.Rat, scale int) (*big.Int, error) {
+	scaled := new(big.Rat).SetInt(bigPow10(scale))
+	scaled.Mul(scaled, amount)
+	if !scaled.IsInt() {
+		return nil, ErrTooManyDecimalPlaces
+	}
+	return new(big.Int).Set(scaled.Num()), nil
+}
+
+// parseBigDecimal parses a plain decimal number like parseDecimal, without limit on
+// its magnitude.
+func parseBigDecimal(s string, scale int) (*big.Int, error) {
+	units, err := parseDecimal(s, scale)
+	if err == nil {
+		return big.NewInt(units), nil
+	}
+	if err != ErrOverflow && err != ErrUnderflow {
+		return nil, err
+	}
+
+	// parseDecimal validated the syntax, only the magnitude is too large
+	integer, fraction, _ := strings.Cut(s, ".")
+	fraction = strings.TrimRight(fraction, "0")
+	fraction += strings.Repeat("0", scale-len(fraction))
+	result, ok := new(big.Int).SetString(integer+fraction, 10)
+	if !ok {
+		return nil, ErrInvalidAmount
+	}
+	return result, nil
+}
+
+// Big returns m as a BigMoney.
+func (m Money) Big() *BigMoney {
+	return &BigMoney{amount: big.NewInt(m.amount), currency: m.currency}
+}
+
+// Money returns b as Money.
+// Returns ErrOverflow or ErrUnderflow if the amount doesn't fit in int64 minor units.
+//
+// Example:
+//
+//	m, err := total.Money()
+//	if errors.Is(err, ErrOverflow) {
+//	    // keep working with BigMoney
+//	}
+func (b BigMoney) Money() (*Money, error) {
+	units := b.units()
+	if !units.IsInt64() {
+		return nil, overflowError(units.Sign() < 0)
+	}
+	return &Money{amount: units.Int64(), currency: b.currency}, nil
+}
+
+// units returns the amount in minor units, zero for the zero value. It must not be modified.
+func (b BigMoney) units() *big.Int {
+	if b.amount == nil {
+		return new(big.Int)
+	}
+	return b.amount
+}
+
+// scale returns the number of decimal places of the currency, 0 if currency is nil.
+func (b BigMoney) scale() int {
+	if b.currency == nil {
+		return 0
+	}
+	return b.currency.MinorUnit
+}
+
+// Currency returns the currency code string (e.g., "USD", "EUR").
+// Returns empty string if currency is nil.
+func (b BigMoney) Currency() string {
+	if b.currency == nil {
+		return ""
+	}
+	return b.currency.code
+}
+
+// MinorUnits returns a copy of the whole amount expressed in minor units.
+func (b BigMoney) MinorUnits() *big.Int {
+	return new(big.Int).Set(b.units())
+}
+
+// Rat returns the exact amount in major units as a new big.Rat.
+func (b BigMoney) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(b.units(), bigPow10(b.scale()))
+}
+
+// DecimalString returns the exact amount as a plain decimal string with as many
+// fractional digits as the currency's minor unit, like Money.DecimalString.
+// It round-trips with NewBigFromString.
+func (b BigMoney) DecimalString() string {
+	return string(b.AppendDecimal(nil))
+}
+
+// AppendDecimal appends the exact amount as formatted by DecimalString to dst
+// and returns the extended buffer.
+func (b BigMoney) AppendDecimal(dst []byte) []byte {
+	return appendBigDecimal(dst, b.units(), b.scale())
+}
+
+// appendBigDecimal appends units scaled by 10^-scale like appendDecimal.
+func appendBigDecimal(dst []byte, units *big.Int, scale int) []byte {
+	if units.Sign() < 0 {
+		dst = append(dst, '-')
+	}
+	digits := new(big.Int).Abs(units).Append(nil, 10)
+	if scale <= 0 {
+		return append(dst, digits...)
+	}
+
+	if len(digits) <= scale {
+		dst = append(dst, '0', '.')
+		for i := len(digits); i < scale; i++ {
+			dst = append(dst, '0')
+		}
+		return append(dst, digits...)
+	}
+
+	split := len(digits) - scale
+	dst = append(dst, digits[:split]...)
+	dst = append(dst, '.')
+	return append(dst, digits[split:]...)
+}
+
+// String returns a string representation of BigMoney in the format "amount currency",
+// like Money.String.
+func (b BigMoney) String() string {
+	if b.currency == nil {
+		return b.units().String() + " (no currency)"
+	}
+	return b.DecimalString() + " " + b.currency.code
+}
+
+// IsZero returns true if the amount is zero.
+func (b BigMoney) IsZero() bool {
+	return b.units().Sign() == 0
+}
+
+// IsNegative returns true if the amount is below zero.
+func (b BigMoney) IsNegative() bool {
+	return b.units().Sign() < 0
+}
+
+// IsPositive returns true if the amount is above zero.
+func (b BigMoney) IsPositive() bool {
+	return b.units().Sign() > 0
+}
+
+// Compare compares two BigMoney values like Money.Compare:
+// -1 if other is less than b, 0 if they are equal and 1 if other is greater.
+// Returns ErrCurrencyMismatch if currencies don't match.
+func (b BigMoney) Compare(other *BigMoney) (int, error) {
+	if other == nil || b.currency == nil || !b.currency.sameAs(other.currency) {
+		return 0, ErrCurrencyMismatch
+	}
+	return other.units().Cmp(b.units()), nil
+}
+
+// Equals returns true if both BigMoney have the same currency and amount.
+// Returns ErrCurrencyMismatch if currencies don't match.
+func (b BigMoney) Equals(other *BigMoney) (bool, error) {
+	c, err := b.Compare(other)
+	return c == 0, err
+}
+
+// Add returns the sum of b and one or more BigMoney values. It never overflows.
+// Returns ErrCurrencyMismatch if currencies don't match or any BigMoney is nil.
+//
+// Example:
+//
+//	total, err := budget.Add(supplement)
+func (b BigMoney) Add(others ...*BigMoney) (*BigMoney, error) {
+	return b.sum(others, (*big.Int).Add)
+}
+
+// Subtract subtracts one or more BigMoney values from b. It never overflows.
+// Returns ErrCurrencyMismatch if currencies don't match or any BigMoney is nil.
+func (b BigMoney) Subtract(others ...*BigMoney) (*BigMoney, error) {
+	return b.sum(others, (*big.Int).Sub)
+}
+
+// sum folds others into b with op.
+func (b BigMoney) sum(others []*BigMoney, op func(z, x, y *big.Int) *big.Int) (*BigMoney, error) {
+	if len(others) == 0 {
+		return nil, ErrNeedAtLeastOneMoney
+	}
+	if b.currency == nil {
+		return nil, ErrCurrencyMismatch
+	}
+
+	result := new(big.Int).Set(b.units())
+	for _, other := range others {
+		if other == nil || !other.currency.sameAs(b.currency) {
+			return nil, ErrCurrencyMismatch
+		}
+		op(result, result, other.units())
+	}
+	return &BigMoney{amount: result, currency: b.currency}, nil
+}
+
+// Multiply returns b multiplied by every factor. It never overflows.
+func (b BigMoney) Multiply(ms ...int64) *BigMoney {
+	result := new(big.Int).Set(b.units())
+	for _, factor := range ms {
+		result.Mul(result, big.NewInt(factor))
+	}
+	return &BigMoney{amount: result, currency: b.currency}
+}
+
+// MulRate returns b scaled by factor and rounded to the minor unit with the given
+// scheme, like Money.MulRate without any range limit.
+// Returns ErrDivisionByZero for the zero Factor.
+func (b BigMoney) MulRate(factor Factor, scheme RoundScheme) (*BigMoney, error) {
+	if factor.den == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return b.scaledBy(big.NewRat(factor.num, factor.den), scheme), nil
+}
+
+// DivideRound returns b divided by d and rounded to the minor unit with the given scheme.
+// Returns ErrDivisionByZero if d is zero.
+func (b BigMoney) DivideRound(d int64, scheme RoundScheme) (*BigMoney, error) {
+	if d == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return b.scaledBy(big.NewRat(1, d), scheme), nil
+}
+
+// scaledBy returns b·r rounded to the minor unit with scheme.
+func (b BigMoney) scaledBy(r *big.Rat, scheme RoundScheme) *BigMoney {
+	scaled := new(big.Rat).SetInt(b.units())
+	scaled.Mul(scaled, r)
+	return &BigMoney{amount: roundRat(scaled, scheme), currency: b.currency}
+}
+
+// Negative returns b with the opposite sign.
+func (b BigMoney) Negative() *BigMoney {
+	return &BigMoney{amount: new(big.Int).Neg(b.units()), currency: b.currency}
+}
+
+// Absolute returns b without its sign.
+func (b BigMoney) Absolute() *BigMoney {
+	return &BigMoney{amount: new(big.Int).Abs(b.units()), currency: b.currency}
+}
+
+// Format formats the BigMoney with the specified locale using standard formatting.
+func (b BigMoney) Format(locale language.Tag) string {
+	return b.FormatWithMode(locale, FormatStandard)
+}
+
+// FormatWithMode formats the BigMoney with the specified locale and format mode.
+func (b BigMoney) FormatWithMode(locale language.Tag, mode FormatMode) string {
+	return b.FormatWithOptions(FormatOptions{
+		Locale: locale,
+		Mode:   mode,
+	})
+}
+
+// FormatWithOptions formats the BigMoney with the specified formatting options.
+// Amounts that fit in Money are formatted exactly as Money.FormatWithOptions does,
+// larger ones with every digit and the locale's separators.
+//
+// Example:
+//
+//	budget, _ := NewBigFromString("3325100000000000000000", IDR)
+//	budget.FormatWithOptions(FormatOptions{Locale: language.Indonesian, Precision: PrecisionDisplay})
+//	// Returns: "Rp3.325.100.000.000.000.000.000,00"
+func (b BigMoney) FormatWithOptions(opts FormatOptions) string {
+	if b.currency == nil {
+		return b.units().String() + " (no currency)"
+	}
+	if m, err := b.Money(); err == nil {
+		return m.FormatWithOptions(opts)
+	}
+
+	units, digits := b.withPrecision(opts.Precision)
+	currencyCode := b.currency.code
+	isNegative := units.Sign() < 0
+	symbol := getCurrencySymbol(&b.currency.Currency, currencyCode)
+	position := getSymbolPosition(&b.currency.Currency)
+	decimals := appendBigDecimal(nil, units, digits)
+
+	switch opts.Mode {
+	case FormatCode:
+		return string(decimals) + " " + currencyCode
+
+	case FormatAccounting:
+		formattedNumber := formatAccounting(formatDecimalString(opts.Locale, string(decimals)), isNegative)
+		if position {
+			return symbol + formattedNumber
+		}
+		return formattedNumber + " " + symbol
+
+	case FormatCompact:
+		return formatBigCompact(units, digits, symbol, position)
+
+	case FormatMinimal:
+		return formatWithSymbol(string(decimals), symbol, position, isNegative)
+
+	default:
+		formattedNumber := formatDecimalString(opts.Locale, string(decimals))
+		return formatWithSymbol(formattedNumber, symbol, position, isNegative)
+	}
+}
+
+// withPrecision returns the amount rounded for the given precision, as a count of
+// 10^-digits units, and the number of decimals to show.
+func (b BigMoney) withPrecision(precision FormatPrecision) (*big.Int, int) {
+	scale := b.scale()
+	if precision == PrecisionMinorUnit {
+		return b.units(), scale
+	}
+
+	r := cashRoundingOf(b.currency)
+	digits, increment, scheme := r.DisplayDigits, big.NewInt(1), RoundHalfEven
+	if precision == PrecisionCash {
+		digits, increment, scheme = r.CashDigits, big.NewInt(max(r.CashIncrement, 1)), RoundHalfUp
+	}
+	if digits > scale {
+		return b.units(), scale
+	}
+
+	// round to a multiple of increment·10^(scale-digits), then drop the hidden digits
+	increment.Mul(increment, bigPow10(scale-digits))
+	steps := roundRat(new(big.Rat).SetFrac(b.units(), increment), scheme)
+	increment.Quo(increment, bigPow10(scale-digits))
+	return steps.Mul(steps, increment), digits
+}
+
+// bigMoneyJSON represents the JSON structure for BigMoney serialization. The amount
+// is written as an exact JSON number.
+type bigMoneyJSON struct {
+	Amount   json.Number `json:"amount"`
+	Currency string      `json:"currency"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// It serializes BigMoney in the format of Money with every digit of the amount:
+// {"amount": 3325100000000000000000.00, "currency": "IDR"}
+func (b BigMoney) MarshalJSON() ([]byte, error) {
+	if b.currency == nil {
+		return nil, errors.New("cannot marshal BigMoney with nil currency")
+	}
+
+	return json.Marshal(bigMoneyJSON{
+		Amount:   json.Number(b.DecimalString()),
+		Currency: b.currency.code,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// It deserializes JSON in the format of Money: {"amount": 100.50, "currency": "USD"},
+// reading the amount exactly. Amounts with an exponent beyond ±1000 are rejected
+// with ErrInvalidAmount.
+func (b *BigMoney) UnmarshalJSON(data []byte) error {
+	var j bigMoneyJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return fmt.Errorf("failed to unmarshal BigMoney: %w", err)
+	}
+
+	if j.Amount == "" {
+		return errors.New("amount field is required")
+	}
+	if j.Currency == "" {
+		return errors.New("currency code cannot be empty")
+	}
+
+	newMoney, err := bigFromJSONNumber(j.Amount, j.Currency)
+	if err != nil {
+		return fmt.Errorf("failed to create BigMoney from JSON: %w", err)
+	}
+
+	*b = *newMoney
+	return nil
+}
+
+// maxJSONExponent bounds the exponent of JSON amounts such as 1.5e3, so that an
+// input like 1e1000000000 can't expand into a billion digits.
+const maxJSONExponent = 1000
+
+// bigFromJSONNumber parses a JSON number exactly. Plain decimals are read like
+// NewBigFromString; numbers with an exponent are accepted up to maxJSONExponent.
+// Returns ErrInvalidAmount for larger exponents.
+func bigFromJSONNumber(n json.Number, currencyCode string) (*BigMoney, error) {
+	s := n.String()
+	i := strings.IndexAny(s, "eE")
+	if i < 0 {
+		return NewBigFromString(s, currencyCode)
+	}
+
+	exponent, err := strconv.Atoi(s[i+1:])
+	if err != nil || exponent < -maxJSONExponent || exponent > maxJSONExponent {
+		return nil, fmt.Errorf("%w: exponent of %s beyond ±%d", ErrInvalidAmount, s, maxJSONExponent)
+	}
+	amount, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, ErrInvalidAmount
+	}
+	return NewBigFromRat(amount, currencyCode)
+}
+
+// Value implements driver.Valuer interface.
+// It returns BigMoney as JSON bytes for database storage, like Money.
+// Returns nil if currency is nil.
+func (b BigMoney) Value() (driver.Value, error) {
+	if b.currency == nil {
+		return nil, nil
+	}
+	return b.MarshalJSON()
+}
+
+// Scan implements sql.Scanner interface.
+// It reads BigMoney from database value (JSON bytes or string).
+func (b *BigMoney) Scan(src interface{}) error {
+	if src == nil {
+		*b = BigMoney{}
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into BigMoney", src)
+	}
+
+	if len(data) == 0 {
+		*b = BigMoney{}
+		return nil
+	}
+
+	return b.UnmarshalJSON(data)
+}
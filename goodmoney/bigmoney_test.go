@@ -0,0 +1,305 @@
+package goodmoney
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func mustBig(t *testing.T, amount, code string) *BigMoney {
+	t.Helper()
+	b, err := NewBigFromString(amount, code)
+	if err != nil {
+		t.Fatalf("NewBigFromString(%q, %s) unexpected error: %v", amount, code, err)
+	}
+	return b
+}
+
+func TestNewBigFromString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount  string
+		code    string
+		want    string
+		wantErr error
+	}{
+		{amount: "3325100000000000000000", code: IDR, want: "3325100000000000000000.00"},
+		{amount: "-92233720368547758.08", code: USD, want: "-92233720368547758.08"},
+		{amount: "92233720368547758.0800", code: USD, want: "92233720368547758.08"},
+		{amount: "100.5", code: USD, want: "100.50"},
+		{amount: "+0.05", code: USD, want: "0.05"},
+		{amount: "99999999999999999999.999", code: USD, wantErr: ErrTooManyDecimalPlaces},
+		{amount: "1e30", code: USD, wantErr: ErrInvalidAmount},
+		{amount: "", code: USD, wantErr: ErrInvalidAmount},
+		{amount: "1", code: "XYZ", wantErr: ErrCurrencyCodeDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		got, err := NewBigFromString(tt.amount, tt.code)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("NewBigFromString(%q) error = %v, want %v", tt.amount, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.DecimalString() != tt.want {
+			t.Errorf("NewBigFromString(%q) = %s, want %s", tt.amount, got.DecimalString(), tt.want)
+		}
+	}
+}
+
+func TestNewBigFromMinorUnitsAndRat(t *testing.T) {
+	t.Parallel()
+
+	units, _ := new(big.Int).SetString("123456789012345678901234", 10)
+	b, err := NewBigFromMinorUnits(units, BHD)
+	if err != nil || b.String() != "123456789012345678901.234 BHD" {
+		t.Fatalf("NewBigFromMinorUnits() = %v, %v, want 123456789012345678901.234 BHD", b, err)
+	}
+	units.SetInt64(0)
+	if b.IsZero() {
+		t.Error("NewBigFromMinorUnits() shares units with the caller")
+	}
+	if got := b.MinorUnits(); got.String() != "123456789012345678901234" {
+		t.Errorf("MinorUnits() = %s", got)
+	}
+	if got := b.Rat().RatString(); got != "61728394506172839450617/500" {
+		t.Errorf("Rat() = %s", got)
+	}
+	if _, err := NewBigFromMinorUnits(nil, USD); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("NewBigFromMinorUnits(nil) error = %v, want %v", err, ErrInvalidAmount)
+	}
+
+	r, _ := new(big.Rat).SetString("1e25")
+	if b, err := NewBigFromRat(r, USD); err != nil || b.DecimalString() != "10000000000000000000000000.00" {
+		t.Errorf("NewBigFromRat(1e25) = %v, %v", b, err)
+	}
+	if _, err := NewBigFromRat(big.NewRat(1, 3), USD); !errors.Is(err, ErrTooManyDecimalPlaces) {
+		t.Errorf("NewBigFromRat(1/3) error = %v, want %v", err, ErrTooManyDecimalPlaces)
+	}
+	if _, err := NewBigFromRat(nil, USD); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("NewBigFromRat(nil) error = %v, want %v", err, ErrInvalidAmount)
+	}
+}
+
+func TestBigMoneyConversion(t *testing.T) {
+	t.Parallel()
+
+	m := mustMinorUnits(-922337203685477580, USD)
+	b := m.Big()
+	back, err := b.Money()
+	if err != nil || *back != *m {
+		t.Fatalf("Big().Money() = %v, %v, want %v", back, err, m)
+	}
+
+	tests := []struct {
+		amount  string
+		wantErr error
+	}{
+		{amount: "92233720368547758.07"},
+		{amount: "-92233720368547758.08"},
+		{amount: "92233720368547758.08", wantErr: ErrOverflow},
+		{amount: "-92233720368547758.09", wantErr: ErrUnderflow},
+	}
+	for _, tt := range tests {
+		got, err := mustBig(t, tt.amount, USD).Money()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Money(%s) error = %v, want %v", tt.amount, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.DecimalString() != tt.amount {
+			t.Errorf("Money(%s) = %s", tt.amount, got.DecimalString())
+		}
+	}
+}
+
+func TestBigMoneyArithmetic(t *testing.T) {
+	t.Parallel()
+
+	maxUSD := mustMinorUnits(9223372036854775807, USD).Big()
+	cent := mustBig(t, "0.01", USD)
+
+	sum, err := maxUSD.Add(cent, cent)
+	if err != nil || sum.DecimalString() != "92233720368547758.09" {
+		t.Errorf("Add() = %v, %v, want 92233720368547758.09 USD", sum, err)
+	}
+	diff, err := sum.Subtract(maxUSD)
+	if err != nil || diff.DecimalString() != "0.02" {
+		t.Errorf("Subtract() = %v, %v, want 0.02 USD", diff, err)
+	}
+	if _, err := maxUSD.Add(mustBig(t, "1", EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add(EUR) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := maxUSD.Add(nil); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add(nil) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := maxUSD.Subtract(); !errors.Is(err, ErrNeedAtLeastOneMoney) {
+		t.Errorf("Subtract() error = %v, want %v", err, ErrNeedAtLeastOneMoney)
+	}
+
+	if got := maxUSD.Multiply(10, -2).DecimalString(); got != "-1844674407370955161.40" {
+		t.Errorf("Multiply() = %s", got)
+	}
+	if got := maxUSD.Negative().Absolute(); got.DecimalString() != maxUSD.DecimalString() {
+		t.Errorf("Negative().Absolute() = %s", got)
+	}
+
+	rate, _ := ParseFactor("1.5")
+	scaled, err := maxUSD.MulRate(rate, RoundHalfEven)
+	if err != nil || scaled.DecimalString() != "138350580552821637.10" {
+		t.Errorf("MulRate() = %v, %v", scaled, err)
+	}
+	if _, err := maxUSD.MulRate(Factor{}, RoundHalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("MulRate(zero Factor) error = %v, want %v", err, ErrDivisionByZero)
+	}
+	third, err := mustBig(t, "100000000000000000000.00", USD).DivideRound(3, RoundCeiling)
+	if err != nil || third.DecimalString() != "33333333333333333333.34" {
+		t.Errorf("DivideRound() = %v, %v", third, err)
+	}
+	if _, err := maxUSD.DivideRound(0, RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("DivideRound(0) error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestBigMoneyCompare(t *testing.T) {
+	t.Parallel()
+
+	small := mustBig(t, "100000000000000000000", USD)
+	large := mustBig(t, "100000000000000000000.01", USD)
+
+	if c, err := small.Compare(large); err != nil || c != 1 {
+		t.Errorf("Compare() = %d, %v, want 1", c, err)
+	}
+	if c, err := large.Compare(small); err != nil || c != -1 {
+		t.Errorf("Compare() = %d, %v, want -1", c, err)
+	}
+	if eq, err := small.Equals(mustBig(t, "100000000000000000000.00", USD)); err != nil || !eq {
+		t.Errorf("Equals() = %v, %v, want true", eq, err)
+	}
+	if _, err := small.Compare(mustBig(t, "1", EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Compare(EUR) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if !large.IsPositive() || large.IsNegative() || large.IsZero() || !(BigMoney{}).IsZero() {
+		t.Error("sign predicates are wrong")
+	}
+}
+
+func TestBigMoneyFormat(t *testing.T) {
+	t.Parallel()
+
+	budget := mustBig(t, "-3325100000000000000000.45", IDR)
+
+	tests := []struct {
+		name string
+		b    *BigMoney
+		opts FormatOptions
+		want string
+	}{
+		{name: "standard", b: budget, opts: FormatOptions{Locale: language.AmericanEnglish}, want: "Rp-3,325,100,000,000,000,000,000.45"},
+		{name: "german", b: budget, opts: FormatOptions{Locale: language.German}, want: "Rp-3.325.100.000.000.000.000.000,45"},
+		{name: "indian grouping", b: budget, opts: FormatOptions{Locale: language.Hindi}, want: "Rp-3,32,51,00,00,00,00,00,00,00,000.45"},
+		{name: "accounting", b: budget, opts: FormatOptions{Locale: language.English, Mode: FormatAccounting}, want: "Rp(3,325,100,000,000,000,000,000.45)"},
+		{name: "compact", b: budget, opts: FormatOptions{Mode: FormatCompact}, want: "Rp-3325100000000.0B"},
+		{name: "minimal", b: budget, opts: FormatOptions{Mode: FormatMinimal}, want: "Rp-3325100000000000000000.45"},
+		{name: "code", b: budget, opts: FormatOptions{Mode: FormatCode}, want: "-3325100000000000000000.45 IDR"},
+		{name: "cash precision", b: budget, opts: FormatOptions{Mode: FormatCode, Precision: PrecisionCash}, want: "-3325100000000000000000 IDR"},
+		{name: "cash increment", b: mustBig(t, "100000000000000000000.03", CHF), opts: FormatOptions{Mode: FormatCode, Precision: PrecisionCash}, want: "100000000000000000000.05 CHF"},
+		{name: "fits in Money", b: mustBig(t, "1234.56", USD), opts: FormatOptions{Locale: language.German}, want: "$1.234,56"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.b.FormatWithOptions(tt.opts); got != tt.want {
+				t.Errorf("FormatWithOptions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigMoneyJSON(t *testing.T) {
+	t.Parallel()
+
+	b := mustBig(t, "3325100000000000000000.05", IDR)
+	data, err := json.Marshal(b)
+	if err != nil || string(data) != `{"amount":3325100000000000000000.05,"currency":"IDR"}` {
+		t.Fatalf("MarshalJSON() = %s, %v", data, err)
+	}
+
+	var got BigMoney
+	if err := json.Unmarshal(data, &got); err != nil || got.String() != b.String() {
+		t.Errorf("UnmarshalJSON() = %v, %v, want %v", got, err, b)
+	}
+
+	// Money's encoding reads back into BigMoney
+	data, _ = json.Marshal(mustMinorUnits(1050, USD))
+	if err := json.Unmarshal(data, &got); err != nil || got.String() != "10.50 USD" {
+		t.Errorf("UnmarshalJSON(%s) = %v, %v, want 10.50 USD", data, got, err)
+	}
+
+	// exponents are read exactly within bounds
+	for input, want := range map[string]string{
+		`{"amount":1.5e3,"currency":"USD"}`:    "1500.00 USD",
+		`{"amount":125E-2,"currency":"USD"}`:   "1.25 USD",
+		`{"amount":1e+21,"currency":"IDR"}`:    "1000000000000000000000.00 IDR",
+		`{"amount":0.0e1000,"currency":"USD"}`: "0.00 USD",
+	} {
+		if err := json.Unmarshal([]byte(input), &got); err != nil || got.String() != want {
+			t.Errorf("UnmarshalJSON(%s) = %v, %v, want %s", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{
+		`{"currency":"USD"}`,
+		`{"amount":1}`,
+		`{"amount":1.001,"currency":"USD"}`,
+		`{"amount":1,"currency":"XYZ"}`,
+		`{"amount":"abc","currency":"USD"}`,
+		`{"amount":1e-3,"currency":"USD"}`,
+	} {
+		if err := json.Unmarshal([]byte(input), &got); err == nil {
+			t.Errorf("UnmarshalJSON(%s) succeeded, want an error", input)
+		}
+	}
+
+	// huge exponents are rejected before they are expanded
+	for _, input := range []string{
+		`{"amount":1e1000000,"currency":"USD"}`,
+		`{"amount":1e-1000000,"currency":"USD"}`,
+		`{"amount":1e99999999999999999999,"currency":"USD"}`,
+	} {
+		if err := json.Unmarshal([]byte(input), &got); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("UnmarshalJSON(%s) error = %v, want %v", input, err, ErrInvalidAmount)
+		}
+	}
+}
+
+func TestBigMoneyValueScan(t *testing.T) {
+	t.Parallel()
+
+	b := mustBig(t, "-100000000000000000000.99", EUR)
+	value, err := b.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+
+	var got BigMoney
+	if err := got.Scan(value); err != nil || got.String() != b.String() {
+		t.Errorf("Scan(Value()) = %v, %v, want %v", got, err, b)
+	}
+	if err := got.Scan(string(value.([]byte))); err != nil || got.String() != b.String() {
+		t.Errorf("Scan(string) = %v, %v, want %v", got, err, b)
+	}
+	if err := got.Scan(nil); err != nil || got.Currency() != "" {
+		t.Errorf("Scan(nil) = %v, %v, want zero BigMoney", got, err)
+	}
+	if err := got.Scan(42); err == nil {
+		t.Error("Scan(42) succeeded, want an error")
+	}
+	if value, err := (BigMoney{}).Value(); value != nil || err != nil {
+		t.Errorf("zero Value() = %v, %v, want nil, nil", value, err)
+	}
+}
@@ -0,0 +1,261 @@
+package goodmoney
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// ErrInfeasibleAllocation happens when the minimums and maximums of an allocation
+// can't all be met.
+var ErrInfeasibleAllocation = errors.New("allocation bounds can't be met")
+
+// AllocationParty is one party of AllocateBounded: its ratio and optional bounds,
+// in the currency of the allocated amount.
+type AllocationParty struct {
+	Ratio int
+	// Min is the least the party receives, nil for no minimum.
+	Min *Money
+	// Max is the most the party receives, nil for no maximum.
+	Max *Money
+}
+
+// AllocationBoundError reports the bound that made an allocation infeasible. It
+// matches ErrInfeasibleAllocation with errors.Is.
+type AllocationBoundError struct {
+	// Party is the index of the party whose minimum can't be met, or -1 when the
+	// maximums together are below the amount.
+	Party int
+	// Maximum tells whether the maximums, rather than a minimum, can't be met.
+	Maximum bool
+	// Bound is the minimum of the party, or the sum of the maximums.
+	Bound *Money
+	// Available is the most the party can receive, the lower of its maximum and
+	// what the minimums of earlier parties left, or the amount to allocate when
+	// the maximums can't be met.
+	Available *Money
+}
+
+func (e *AllocationBoundError) Error() string {
+	if e.Maximum {
+		return fmt.Sprintf("%s: maximums total %s, below the %s to allocate", ErrInfeasibleAllocation, e.Bound, e.Available)
+	}
+	return fmt.Sprintf("%s: party %d minimum %s exceeds the %s available", ErrInfeasibleAllocation, e.Party, e.Bound, e.Available)
+}
+
+// Is reports whether target is ErrInfeasibleAllocation.
+func (e *AllocationBoundError) Is(target error) bool {
+	return target == ErrInfeasibleAllocation
+}
+
+// AllocateBounded splits money by the ratios of parties that each receive at least
+// their Min and at most their Max. Every party gets the same amount per unit of
+// ratio, except parties held at a bound; what capped parties can't take is
+// redistributed to the others. Leftover minor units are handed out with the given
+// strategy without breaking any bound.
+// Returns an AllocationBoundError if the bounds can't be met, an error wrapping
+// ErrDivisionByZero if every ratio is zero and the minimums don't add up to m,
+// ErrCurrencyMismatch if a bound isn't in m's currency and an error for negative
+// amounts, bounds or ratios.
+//
+// Example:
+//
+//	floor, _ := NewFromString("5.00", USD)
+//	ceiling, _ := NewFromString("40.00", USD)
+//	a, err := payout.AllocateBounded(LargestRemainder{},
+//	    AllocationParty{Ratio: 5, Max: ceiling},
+//	    AllocationParty{Ratio: 3},
+//	    AllocationParty{Ratio: 1, Min: floor},
+//	)
+func (m *Money) AllocateBounded(strategy AllocationStrategy, parties ...AllocationParty) (*Allocation, error) {
+	if len(parties) == 0 {
+		return nil, errors.New("no parties specified")
+	}
+	if m.amount < 0 {
+		return nil, errors.New("negative amounts can't be allocated with bounds")
+	}
+	if strategy == nil {
+		strategy = RoundRobin{}
+	}
+
+	// validate the parties and check the minimums fit, in order
+	mins := make([]int64, len(parties))
+	maxs := make([]*int64, len(parties))
+	left := m.amount
+	for i, p := range parties {
+		if p.Ratio < 0 {
+			return nil, errors.New("negative ratios not allowed")
+		}
+		for _, bound := range []*Money{p.Min, p.Max} {
+			if bound == nil {
+				continue
+			}
+			if !bound.currency.sameAs(m.currency) {
+				return nil, ErrCurrencyMismatch
+			}
+			if bound.amount < 0 {
+				return nil, errors.New("negative bounds not allowed")
+			}
+		}
+		if p.Min != nil {
+			mins[i] = p.Min.amount
+		}
+		available := left
+		if p.Max != nil {
+			maxs[i] = &p.Max.amount
+			available = min(available, p.Max.amount)
+		}
+		if mins[i] > available {
+			return nil, &AllocationBoundError{
+				Party:     i,
+				Bound:     &Money{amount: mins[i], currency: m.currency},
+				Available: &Money{amount: available, currency: m.currency},
+			}
+		}
+		left -= mins[i]
+	}
+
+	shares, err := boundedShares(m, parties, mins, maxs)
+	if err != nil {
+		return nil, err
+	}
+
+	// round every share down; the fractions are over a common denominator so the
+	// strategies can compare them
+	denominator := big.NewInt(1)
+	for _, s := range shares {
+		denominator.Mul(denominator, new(big.Int).Quo(s.Denom(), new(big.Int).GCD(nil, nil, denominator, s.Denom())))
+	}
+	floors := make([]int64, len(parties))
+	allocationShares := make([]allocationShare, len(parties))
+	leftover := m.amount
+	for i, s := range shares {
+		floor, remainder := new(big.Int).DivMod(s.Num(), s.Denom(), new(big.Int))
+		floors[i] = floor.Int64()
+		leftover -= floors[i]
+
+		// held at a bound, the share is whole and the party takes no leftover
+		weight := big.NewInt(int64(parties[i].Ratio))
+		if (floors[i] == mins[i] && remainder.Sign() == 0) || (maxs[i] != nil && floors[i] == *maxs[i]) {
+			weight.SetInt64(0)
+		}
+		allocationShares[i] = allocationShare{
+			weight:    weight,
+			remainder: remainder.Mul(remainder, new(big.Int).Quo(denominator, s.Denom())),
+		}
+	}
+
+	extra := strategy.distribute(allocationShares, leftover)
+
+	// a strategy may give a party more than its maximum, pass the excess on
+	excess := int64(0)
+	for i := range extra {
+		if maxs[i] != nil && floors[i]+extra[i] > *maxs[i] {
+			excess += floors[i] + extra[i] - *maxs[i]
+			extra[i] = *maxs[i] - floors[i]
+		}
+	}
+	for i := 0; excess > 0; i = (i + 1) % len(extra) {
+		if allocationShares[i].weight.Sign() > 0 && (maxs[i] == nil || floors[i]+extra[i] < *maxs[i]) {
+			extra[i]++
+			excess--
+		}
+	}
+
+	a := &Allocation{Parts: make([]*Money, len(parties)), Extra: extra}
+	for i := range parties {
+		a.Parts[i] = &Money{amount: floors[i] + extra[i], currency: m.currency}
+	}
+	return a, nil
+}
+
+// boundedShares returns the exact share of each party, clamp(λ·ratio, min, max) with
+// λ such that the shares sum to m. The clamped sum grows piecewise linearly with λ,
+// so λ is found on the segment between the breakpoints where a party reaches a bound.
+func boundedShares(m *Money, parties []AllocationParty, mins []int64, maxs []*int64) ([]*big.Rat, error) {
+	sharesAt := func(lambda *big.Rat) ([]*big.Rat, *big.Rat) {
+		shares := make([]*big.Rat, len(parties))
+		total := new(big.Rat)
+		for i, p := range parties {
+			s := new(big.Rat).Mul(lambda, new(big.Rat).SetInt64(int64(p.Ratio)))
+			if lo := new(big.Rat).SetInt64(mins[i]); s.Cmp(lo) < 0 {
+				s = lo
+			}
+			if maxs[i] != nil {
+				if hi := new(big.Rat).SetInt64(*maxs[i]); s.Cmp(hi) > 0 {
+					s = hi
+				}
+			}
+			shares[i] = s
+			total.Add(total, s)
+		}
+		return shares, total
+	}
+
+	// the values of λ where a party reaches its minimum or its maximum
+	breakpoints := []*big.Rat{new(big.Rat)}
+	for i, p := range parties {
+		if p.Ratio == 0 {
+			continue
+		}
+		breakpoints = append(breakpoints, big.NewRat(mins[i], int64(p.Ratio)))
+		if maxs[i] != nil {
+			breakpoints = append(breakpoints, big.NewRat(*maxs[i], int64(p.Ratio)))
+		}
+	}
+	sort.Slice(breakpoints, func(a, b int) bool {
+		return breakpoints[a].Cmp(breakpoints[b]) < 0
+	})
+
+	amount := new(big.Rat).SetInt64(m.amount)
+	previous, previousTotal := new(big.Rat), new(big.Rat)
+	for _, b := range breakpoints {
+		shares, total := sharesAt(b)
+		switch total.Cmp(amount) {
+		case 0:
+			return shares, nil
+		case 1:
+			// interpolate between the previous breakpoint and this one
+			slope := new(big.Rat).Quo(new(big.Rat).Sub(total, previousTotal), new(big.Rat).Sub(b, previous))
+			shares, _ := sharesAt(lambdaOnSegment(previous, previousTotal, amount, slope))
+			return shares, nil
+		}
+		previous, previousTotal = b, total
+	}
+
+	// past the last breakpoint only the parties without a maximum grow
+	slope := new(big.Rat)
+	ratios, capped := 0, false
+	for i, p := range parties {
+		ratios += p.Ratio
+		if maxs[i] == nil {
+			slope.Add(slope, new(big.Rat).SetInt64(int64(p.Ratio)))
+		} else {
+			capped = true
+		}
+	}
+	if slope.Sign() == 0 {
+		// nothing grows: either no party has a ratio, or every party with one is
+		// held at its maximum
+		if ratios == 0 || !capped {
+			return nil, fmt.Errorf("%w: ratios sum to zero", ErrDivisionByZero)
+		}
+		return nil, &AllocationBoundError{
+			Party:     -1,
+			Maximum:   true,
+			Bound:     &Money{amount: previousTotal.Num().Int64(), currency: m.currency},
+			Available: &Money{amount: m.amount, currency: m.currency},
+		}
+	}
+	shares, _ := sharesAt(lambdaOnSegment(previous, previousTotal, amount, slope))
+	return shares, nil
+}
+
+// lambdaOnSegment returns the λ where a linear total starting at total for start
+// and growing by slope reaches amount.
+func lambdaOnSegment(start, total, amount, slope *big.Rat) *big.Rat {
+	lambda := new(big.Rat).Sub(amount, total)
+	lambda.Quo(lambda, slope)
+	return lambda.Add(lambda, start)
+}
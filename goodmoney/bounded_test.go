@@ -0,0 +1,203 @@
+package goodmoney
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAllocateBounded(t *testing.T) {
+	t.Parallel()
+
+	usd := func(units int64) *Money { return mustMinorUnits(units, USD) }
+
+	tests := []struct {
+		name      string
+		units     int64
+		strategy  AllocationStrategy
+		parties   []AllocationParty
+		want      []int64
+		receivers []int
+	}{
+		{
+			name:     "cap redistributed, floor already met",
+			units:    10000,
+			strategy: LargestRemainder{},
+			parties:  []AllocationParty{{Ratio: 5, Max: usd(4000)}, {Ratio: 3}, {Ratio: 1, Min: usd(500)}},
+			want:     []int64{4000, 4500, 1500},
+		},
+		{
+			name:     "floor binding",
+			units:    10000,
+			strategy: LargestRemainder{},
+			parties:  []AllocationParty{{Ratio: 9}, {Ratio: 1, Min: usd(2000)}},
+			want:     []int64{8000, 2000},
+		},
+		{
+			name:     "floor for a zero ratio",
+			units:    1000,
+			strategy: LargestRemainder{},
+			parties:  []AllocationParty{{Ratio: 1}, {Ratio: 0, Min: usd(100)}, {Ratio: 2}},
+			want:     []int64{300, 100, 600},
+		},
+		{
+			name:      "leftover after a cap",
+			units:     1001,
+			strategy:  LargestRemainder{},
+			parties:   []AllocationParty{{Ratio: 1, Max: usd(100)}, {Ratio: 1}, {Ratio: 1}},
+			want:      []int64{100, 451, 450},
+			receivers: []int{1},
+		},
+		{
+			name:      "capped party takes no leftover",
+			units:     1000,
+			strategy:  RemainderToLast{},
+			parties:   []AllocationParty{{Ratio: 1}, {Ratio: 1}, {Ratio: 1, Max: usd(333)}},
+			want:      []int64{333, 334, 333},
+			receivers: []int{1},
+		},
+		{
+			name:      "leftover beyond a cap is passed on",
+			units:     17,
+			strategy:  RemainderToLargestShare{},
+			parties:   []AllocationParty{{Ratio: 2, Max: usd(4)}, {Ratio: 1}, {Ratio: 1}, {Ratio: 1}, {Ratio: 1}, {Ratio: 1}, {Ratio: 1}, {Ratio: 1}},
+			want:      []int64{4, 2, 2, 2, 2, 2, 2, 1},
+			receivers: []int{0, 1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:      "nil strategy and no bounds",
+			units:     100,
+			parties:   []AllocationParty{{Ratio: 1}, {Ratio: 1}, {Ratio: 1}},
+			want:      []int64{34, 33, 33},
+			receivers: []int{0},
+		},
+		{
+			name:     "minimums use up the amount",
+			units:    1000,
+			strategy: LargestRemainder{},
+			parties:  []AllocationParty{{Ratio: 1, Min: usd(600)}, {Ratio: 1, Min: usd(400)}},
+			want:     []int64{600, 400},
+		},
+		{
+			name:     "maximums take the whole amount",
+			units:    1000,
+			strategy: LargestRemainder{},
+			parties:  []AllocationParty{{Ratio: 1, Max: usd(600)}, {Ratio: 3, Max: usd(400)}},
+			want:     []int64{600, 400},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := usd(tt.units).AllocateBounded(tt.strategy, tt.parties...)
+			if err != nil {
+				t.Fatalf("AllocateBounded() unexpected error: %v", err)
+			}
+			units := make([]int64, len(got.Parts))
+			for i, part := range got.Parts {
+				units[i] = part.MinorUnits()
+			}
+			if !reflect.DeepEqual(units, tt.want) {
+				t.Errorf("AllocateBounded() = %v, want %v", units, tt.want)
+			}
+			if receivers := got.Receivers(); !reflect.DeepEqual(receivers, tt.receivers) {
+				t.Errorf("Receivers() = %v, want %v", receivers, tt.receivers)
+			}
+		})
+	}
+}
+
+func TestAllocateBoundedInfeasible(t *testing.T) {
+	t.Parallel()
+
+	usd := func(units int64) *Money { return mustMinorUnits(units, USD) }
+
+	tests := []struct {
+		name    string
+		parties []AllocationParty
+		want    AllocationBoundError
+		message string
+	}{
+		{
+			name:    "minimums above the amount",
+			parties: []AllocationParty{{Ratio: 1, Min: usd(600)}, {Ratio: 1, Min: usd(600)}},
+			want:    AllocationBoundError{Party: 1, Bound: usd(600), Available: usd(400)},
+			message: "allocation bounds can't be met: party 1 minimum 6.00 USD exceeds the 4.00 USD available",
+		},
+		{
+			name:    "minimum above the maximum",
+			parties: []AllocationParty{{Ratio: 1, Min: usd(500), Max: usd(400)}, {Ratio: 1}},
+			want:    AllocationBoundError{Party: 0, Bound: usd(500), Available: usd(400)},
+			message: "allocation bounds can't be met: party 0 minimum 5.00 USD exceeds the 4.00 USD available",
+		},
+		{
+			name:    "maximums below the amount",
+			parties: []AllocationParty{{Ratio: 1, Max: usd(300)}, {Ratio: 2, Max: usd(300)}, {Ratio: 0, Min: usd(100)}},
+			want:    AllocationBoundError{Party: -1, Maximum: true, Bound: usd(700), Available: usd(1000)},
+			message: "allocation bounds can't be met: maximums total 7.00 USD, below the 10.00 USD to allocate",
+		},
+		{
+			name:    "zero ratios take no excess",
+			parties: []AllocationParty{{Ratio: 1, Max: usd(300)}, {Ratio: 0}},
+			want:    AllocationBoundError{Party: -1, Maximum: true, Bound: usd(300), Available: usd(1000)},
+			message: "allocation bounds can't be met: maximums total 3.00 USD, below the 10.00 USD to allocate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := usd(1000).AllocateBounded(LargestRemainder{}, tt.parties...)
+			if !errors.Is(err, ErrInfeasibleAllocation) {
+				t.Fatalf("AllocateBounded() error = %v, want %v", err, ErrInfeasibleAllocation)
+			}
+			var boundErr *AllocationBoundError
+			if !errors.As(err, &boundErr) || !reflect.DeepEqual(*boundErr, tt.want) {
+				t.Errorf("AllocateBounded() error = %#v, want %#v", boundErr, tt.want)
+			}
+			if err.Error() != tt.message {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.message)
+			}
+		})
+	}
+}
+
+func TestAllocateBoundedInvalid(t *testing.T) {
+	t.Parallel()
+
+	m := mustMinorUnits(1000, USD)
+	if _, err := m.AllocateBounded(nil); err == nil {
+		t.Error("AllocateBounded() without parties succeeded, want an error")
+	}
+	if _, err := m.AllocateBounded(nil, AllocationParty{Ratio: 1, Max: mustMinorUnits(100, EUR)}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("AllocateBounded() with a EUR bound error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := m.AllocateBounded(nil, AllocationParty{Ratio: 1, Min: mustMinorUnits(-1, USD)}); err == nil {
+		t.Error("AllocateBounded() with a negative bound succeeded, want an error")
+	}
+	if _, err := m.AllocateBounded(nil, AllocationParty{Ratio: -1}); err == nil {
+		t.Error("AllocateBounded() with a negative ratio succeeded, want an error")
+	}
+	if _, err := mustMinorUnits(-1000, USD).AllocateBounded(nil, AllocationParty{Ratio: 1}); err == nil {
+		t.Error("AllocateBounded() of a negative amount succeeded, want an error")
+	}
+
+	// with every ratio zero nothing can take what the minimums leave, capped or not
+	for _, parties := range [][]AllocationParty{
+		{{Ratio: 0}, {Ratio: 0}},
+		{{Ratio: 0, Min: mustMinorUnits(100, USD)}, {Ratio: 0}},
+		{{Ratio: 0, Max: mustMinorUnits(300, USD)}, {Ratio: 0}},
+	} {
+		_, err := m.AllocateBounded(nil, parties...)
+		if !errors.Is(err, ErrDivisionByZero) || errors.Is(err, ErrInfeasibleAllocation) {
+			t.Errorf("AllocateBounded() with zero ratios error = %v, want %v", err, ErrDivisionByZero)
+		}
+	}
+	// unless the minimums already make up the amount
+	if _, err := m.AllocateBounded(nil, AllocationParty{Min: mustMinorUnits(600, USD)}, AllocationParty{Min: mustMinorUnits(400, USD)}); err != nil {
+		t.Errorf("AllocateBounded() with zero ratios and minimums making up the amount unexpected error: %v", err)
+	}
+}
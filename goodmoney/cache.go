@@ -0,0 +1,272 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrStaleRate happens when a cached rate is too old to be used and can't be refreshed.
+var ErrStaleRate = errors.New("exchange rate is stale")
+
+// StaleRateError reports a cached rate older than the maximum staleness that could
+// not be refreshed. It matches ErrStaleRate with errors.Is and unwraps to the error
+// that prevented the refresh.
+type StaleRateError struct {
+	Base  string
+	Quote string
+	// Age is how long ago the cached rate was fetched.
+	Age time.Duration
+	// MaxStale is the maximum staleness that was exceeded.
+	MaxStale time.Duration
+	// Err is why the rate could not be refreshed.
+	Err error
+}
+
+// Error implements error.
+func (e *StaleRateError) Error() string {
+	return fmt.Sprintf("%s: %s fetched %s ago, limit %s: %v", ErrStaleRate, pairKey(e.Base, e.Quote), e.Age, e.MaxStale, e.Err)
+}
+
+// Is reports whether target is ErrStaleRate.
+func (e *StaleRateError) Is(target error) bool {
+	return target == ErrStaleRate
+}
+
+// Unwrap returns the error that prevented the refresh.
+func (e *StaleRateError) Unwrap() error {
+	return e.Err
+}
+
+// CacheOptions configures a CachingRateProvider.
+type CacheOptions struct {
+	// TTL is how long a fetched rate is used before it is refreshed. Zero means
+	// every request is refreshed, which still coalesces concurrent requests.
+	TTL time.Duration
+	// MaxStale is how old a rate may be to still be used when refreshing it fails.
+	// Older rates fail with a StaleRateError. Zero means a failed refresh always fails.
+	MaxStale time.Duration
+	// FetchTimeout bounds each fetch from the provider, so that a provider that hangs
+	// fails the requests waiting for it instead of blocking them. Zero means 30 seconds.
+	FetchTimeout time.Duration
+	// Now returns the current time, time.Now if nil.
+	Now func() time.Time
+}
+
+// CachingRateProvider caches the rates of a RateProvider.
+//
+// A rate is fetched once and used until its TTL expires, which can be set per pair.
+// Concurrent requests for the same rate wait for a single fetch. When a refresh fails
+// the expired rate is still used up to MaxStale, after that requests fail with a
+// StaleRateError. Rates of a point in time are cached separately from the latest rate.
+// Rates too old to be used even as a fallback are evicted.
+// It is safe for concurrent use.
+type CachingRateProvider struct {
+	provider     RateProvider
+	ttl          time.Duration
+	maxStale     time.Duration
+	fetchTimeout time.Duration
+	now          func() time.Time
+
+	mu        sync.Mutex
+	pairTTL   map[string]time.Duration
+	entries   map[string]cachedRate
+	inflight  map[string]*rateCall
+	lastSweep time.Time
+}
+
+// defaultFetchTimeout is the FetchTimeout used when none is set.
+const defaultFetchTimeout = 30 * time.Second
+
+// cachedRate is a rate of a pair and when it was fetched.
+type cachedRate struct {
+	pair      string
+	rate      Rate
+	fetchedAt time.Time
+}
+
+// rateCall is a fetch in progress that other requests can wait for.
+type rateCall struct {
+	done chan struct{}
+	rate Rate
+	err  error
+}
+
+// NewCachingRateProvider returns a CachingRateProvider over provider.
+// Combine it with a ChainRateProvider to fall back on other sources.
+//
+// Example:
+//
+//	rates := NewCachingRateProvider(NewChainRateProvider(primary, backup), CacheOptions{
+//		TTL:      time.Minute,
+//		MaxStale: time.Hour,
+//	})
+func NewCachingRateProvider(provider RateProvider, opts CacheOptions) *CachingRateProvider {
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	fetchTimeout := opts.FetchTimeout
+	if fetchTimeout <= 0 {
+		fetchTimeout = defaultFetchTimeout
+	}
+	return &CachingRateProvider{
+		provider:     provider,
+		ttl:          opts.TTL,
+		maxStale:     opts.MaxStale,
+		fetchTimeout: fetchTimeout,
+		now:          now,
+		pairTTL:      make(map[string]time.Duration),
+		entries:      make(map[string]cachedRate),
+		inflight:     make(map[string]*rateCall),
+	}
+}
+
+// SetTTL sets the TTL of the base/quote pair, overriding CacheOptions.TTL.
+func (p *CachingRateProvider) SetTTL(base, quote string, ttl time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pairTTL[pairKey(base, quote)] = ttl
+}
+
+// Rate implements RateProvider.
+func (p *CachingRateProvider) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	pair := pairKey(base, quote)
+	key := pair
+	if !at.IsZero() {
+		key += "@" + strconv.FormatInt(at.UnixNano(), 10)
+	}
+
+	p.mu.Lock()
+	entry, cached := p.entries[key]
+	if cached && p.now().Sub(entry.fetchedAt) < p.pairTTLLocked(pair) {
+		p.mu.Unlock()
+		return entry.rate, nil
+	}
+
+	call, fetching := p.inflight[key]
+	if !fetching {
+		call = &rateCall{done: make(chan struct{})}
+		p.inflight[key] = call
+		// the fetch outlives the request that started it, other requests may wait for it
+		go p.fetch(context.WithoutCancel(ctx), key, pair, call, base, quote, at)
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return Rate{}, ctx.Err()
+	}
+	if call.err == nil {
+		return call.rate, nil
+	}
+
+	// the refresh failed, fall back on the expired rate while it isn't too old
+	p.mu.Lock()
+	entry, cached = p.entries[key]
+	p.mu.Unlock()
+	if !cached {
+		return Rate{}, call.err
+	}
+	age := p.now().Sub(entry.fetchedAt)
+	if age > p.maxStale {
+		return Rate{}, &StaleRateError{Base: base, Quote: quote, Age: age, MaxStale: p.maxStale, Err: call.err}
+	}
+	return entry.rate, nil
+}
+
+// fetch fetches a rate for call within the fetch timeout and caches it.
+func (p *CachingRateProvider) fetch(ctx context.Context, key, pair string, call *rateCall, base, quote string, at time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, p.fetchTimeout)
+	defer cancel()
+
+	// a provider that ignores ctx must not hold the requests waiting for it
+	result := make(chan rateCall, 1)
+	go func() {
+		rate, err := p.provider.Rate(ctx, base, quote, at)
+		result <- rateCall{rate: rate, err: err}
+	}()
+	var rate Rate
+	var err error
+	select {
+	case r := <-result:
+		rate, err = r.rate, r.err
+	case <-ctx.Done():
+		err = fmt.Errorf("fetching %s: %w", pair, ctx.Err())
+	}
+
+	p.mu.Lock()
+	now := p.now()
+	delete(p.inflight, key)
+	if err == nil {
+		p.entries[key] = cachedRate{pair: pair, rate: rate, fetchedAt: now}
+		p.evictLocked(now)
+	}
+	p.mu.Unlock()
+
+	call.rate, call.err = rate, err
+	close(call.done)
+}
+
+// pairTTLLocked returns the TTL of pair. p.mu must be held.
+func (p *CachingRateProvider) pairTTLLocked(pair string) time.Duration {
+	if ttl, ok := p.pairTTL[pair]; ok {
+		return ttl
+	}
+	return p.ttl
+}
+
+// evictLocked drops the rates past both their TTL and MaxStale, which can't be used
+// anymore, at most once per TTL or MaxStale so that fetches stay cheap. Without it
+// the rates of every point in time ever asked for would be kept. Rates being
+// refreshed are kept for the StaleRateError of a failed refresh. p.mu must be held.
+func (p *CachingRateProvider) evictLocked(now time.Time) {
+	if now.Sub(p.lastSweep) < max(p.ttl, p.maxStale) {
+		return
+	}
+	p.lastSweep = now
+	for key, entry := range p.entries {
+		if _, fetching := p.inflight[key]; fetching {
+			continue
+		}
+		if now.Sub(entry.fetchedAt) > max(p.pairTTLLocked(entry.pair), p.maxStale) {
+			delete(p.entries, key)
+		}
+	}
+}
+
+// ChainRateProvider asks a list of RateProviders in order and returns the first rate found.
+type ChainRateProvider struct {
+	providers []RateProvider
+}
+
+// NewChainRateProvider returns a ChainRateProvider asking providers in the given order.
+func NewChainRateProvider(providers ...RateProvider) *ChainRateProvider {
+	return &ChainRateProvider{
+		providers: append([]RateProvider(nil), providers...),
+	}
+}
+
+// Rate implements RateProvider. When every provider fails the errors are joined,
+// so errors.Is matches any of them.
+func (p *ChainRateProvider) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	var errs []error
+	for _, provider := range p.providers {
+		rate, err := provider.Rate(ctx, base, quote, at)
+		if err == nil {
+			return rate, nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return Rate{}, ctxErr
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, pairKey(base, quote))
+	}
+	return Rate{}, errors.Join(errs...)
+}
@@ -0,0 +1,331 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stubRateProvider is an in-memory RateProvider safe for concurrent use that counts
+// fetches, can fail, and can hold fetches until released.
+type stubRateProvider struct {
+	mu    sync.Mutex
+	rate  *big.Rat
+	err   error
+	gate  chan struct{}
+	calls atomic.Int64
+}
+
+func (p *stubRateProvider) set(rate *big.Rat, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rate, p.err = rate, err
+}
+
+func (p *stubRateProvider) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	p.calls.Add(1)
+	if p.gate != nil {
+		<-p.gate
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return Rate{}, p.err
+	}
+	return Rate{Base: base, Quote: quote, Value: p.rate, Source: "stub"}, nil
+}
+
+// fakeClock is a settable clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestCachingRateProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errDown := errors.New("rate source down")
+	clock := &fakeClock{now: time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)}
+	stub := &stubRateProvider{rate: big.NewRat(57, 1)}
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Minute, MaxStale: time.Hour, Now: clock.Now})
+
+	steps := []struct {
+		name      string
+		advance   time.Duration
+		rate      *big.Rat
+		err       error
+		wantValue *big.Rat
+		wantCalls int64
+		wantErr   error
+	}{
+		{name: "first request fetches", rate: big.NewRat(57, 1), wantValue: big.NewRat(57, 1), wantCalls: 1},
+		{name: "within TTL is cached", advance: 59 * time.Second, rate: big.NewRat(58, 1), wantValue: big.NewRat(57, 1), wantCalls: 1},
+		{name: "expired is refreshed", advance: time.Second, rate: big.NewRat(58, 1), wantValue: big.NewRat(58, 1), wantCalls: 2},
+		{name: "failed refresh uses the stale rate", advance: 30 * time.Minute, err: errDown, wantValue: big.NewRat(58, 1), wantCalls: 3},
+		{name: "at the staleness limit", advance: 30 * time.Minute, err: errDown, wantValue: big.NewRat(58, 1), wantCalls: 4},
+		{name: "beyond the staleness limit", advance: time.Second, err: errDown, wantErr: ErrStaleRate, wantCalls: 5},
+		{name: "recovers", rate: big.NewRat(59, 1), wantValue: big.NewRat(59, 1), wantCalls: 6},
+	}
+
+	for _, step := range steps {
+		clock.advance(step.advance)
+		stub.set(step.rate, step.err)
+
+		rate, err := rates.Rate(ctx, USD, ETB, time.Time{})
+		if !errors.Is(err, step.wantErr) {
+			t.Fatalf("%s: Rate() error = %v, want %v", step.name, err, step.wantErr)
+		}
+		if step.wantValue != nil && rate.Value.Cmp(step.wantValue) != 0 {
+			t.Errorf("%s: Rate() = %s, want %s", step.name, rate.Value.RatString(), step.wantValue.RatString())
+		}
+		if got := stub.calls.Load(); got != step.wantCalls {
+			t.Errorf("%s: %d fetches, want %d", step.name, got, step.wantCalls)
+		}
+	}
+}
+
+func TestCachingRateProviderStaleRateError(t *testing.T) {
+	t.Parallel()
+
+	errDown := errors.New("rate source down")
+	clock := &fakeClock{now: time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)}
+	stub := &stubRateProvider{rate: big.NewRat(57, 1)}
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Minute, Now: clock.Now})
+
+	if _, err := rates.Rate(context.Background(), USD, ETB, time.Time{}); err != nil {
+		t.Fatalf("Rate() unexpected error: %v", err)
+	}
+	clock.advance(2 * time.Minute)
+	stub.set(nil, errDown)
+
+	_, err := rates.Rate(context.Background(), USD, ETB, time.Time{})
+	var stale *StaleRateError
+	if !errors.As(err, &stale) {
+		t.Fatalf("Rate() error = %v, want a StaleRateError", err)
+	}
+	if stale.Base != USD || stale.Quote != ETB || stale.Age != 2*time.Minute || stale.MaxStale != 0 {
+		t.Errorf("StaleRateError = %+v, want USD/ETB fetched 2m ago", stale)
+	}
+	if !errors.Is(err, errDown) || !errors.Is(err, ErrStaleRate) {
+		t.Errorf("Rate() error = %v, want both ErrStaleRate and the refresh error", err)
+	}
+}
+
+func TestCachingRateProviderNeverFetched(t *testing.T) {
+	t.Parallel()
+
+	errDown := errors.New("rate source down")
+	rates := NewCachingRateProvider(&stubRateProvider{err: errDown}, CacheOptions{TTL: time.Minute, MaxStale: time.Hour})
+
+	_, err := rates.Rate(context.Background(), USD, ETB, time.Time{})
+	if !errors.Is(err, errDown) || errors.Is(err, ErrStaleRate) {
+		t.Errorf("Rate() error = %v, want %v", err, errDown)
+	}
+}
+
+func TestCachingRateProviderPairTTL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)}
+	stub := &stubRateProvider{rate: big.NewRat(57, 1)}
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Hour, Now: clock.Now})
+	rates.SetTTL(USD, ETB, time.Second)
+
+	for _, quote := range []string{ETB, KES} {
+		if _, err := rates.Rate(ctx, USD, quote, time.Time{}); err != nil {
+			t.Fatalf("Rate() unexpected error: %v", err)
+		}
+	}
+	clock.advance(time.Minute)
+	for _, quote := range []string{ETB, KES} {
+		if _, err := rates.Rate(ctx, USD, quote, time.Time{}); err != nil {
+			t.Fatalf("Rate() unexpected error: %v", err)
+		}
+	}
+
+	// USD/KES was cached for an hour, USD/ETB for a second
+	if got := stub.calls.Load(); got != 3 {
+		t.Errorf("%d fetches, want 3", got)
+	}
+}
+
+func TestCachingRateProviderPointInTime(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stub := &stubRateProvider{rate: big.NewRat(57, 1)}
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Hour})
+
+	day1 := time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	for _, at := range []time.Time{day1, day2, {}, day1, day2, {}} {
+		if _, err := rates.Rate(ctx, USD, ETB, at); err != nil {
+			t.Fatalf("Rate() unexpected error: %v", err)
+		}
+	}
+	if got := stub.calls.Load(); got != 3 {
+		t.Errorf("%d fetches, want 3", got)
+	}
+}
+
+func TestCachingRateProviderEviction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)}
+	stub := &stubRateProvider{rate: big.NewRat(57, 1)}
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Minute, MaxStale: time.Hour, Now: clock.Now})
+
+	// invoices converted at their own timestamps
+	invoiced := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 100 {
+		if _, err := rates.Rate(ctx, USD, ETB, invoiced.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("Rate() unexpected error: %v", err)
+		}
+	}
+
+	// past their TTL and MaxStale the rates are dropped on the next fetch
+	clock.advance(2 * time.Hour)
+	if _, err := rates.Rate(ctx, USD, ETB, time.Time{}); err != nil {
+		t.Fatalf("Rate() unexpected error: %v", err)
+	}
+	rates.mu.Lock()
+	defer rates.mu.Unlock()
+	if got := len(rates.entries); got != 1 {
+		t.Errorf("%d cached rates, want 1", got)
+	}
+}
+
+func TestCachingRateProviderCoalescing(t *testing.T) {
+	t.Parallel()
+
+	const requests = 50
+	stub := &stubRateProvider{rate: big.NewRat(57, 1), gate: make(chan struct{})}
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Minute})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, requests)
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rate, err := rates.Rate(context.Background(), USD, ETB, time.Time{})
+			if err == nil && rate.Value.Cmp(big.NewRat(57, 1)) != 0 {
+				err = errors.New("unexpected rate " + rate.Value.RatString())
+			}
+			errs <- err
+		}()
+	}
+
+	// wait for the fetch to start, give the other requests time to queue behind it
+	for stub.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(stub.gate)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Rate() unexpected error: %v", err)
+		}
+	}
+	if got := stub.calls.Load(); got != 1 {
+		t.Errorf("%d fetches for %d concurrent requests, want 1", got, requests)
+	}
+}
+
+func TestCachingRateProviderCanceledWaiter(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubRateProvider{rate: big.NewRat(57, 1), gate: make(chan struct{})}
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Minute})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := rates.Rate(ctx, USD, ETB, time.Time{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Rate() error = %v, want %v", err, context.Canceled)
+	}
+
+	// the fetch continues for the requests that come next
+	close(stub.gate)
+	rate, err := rates.Rate(context.Background(), USD, ETB, time.Time{})
+	if err != nil || rate.Value.Cmp(big.NewRat(57, 1)) != 0 {
+		t.Errorf("Rate() = %v, %v, want 57", rate.Value, err)
+	}
+	if got := stub.calls.Load(); got != 1 {
+		t.Errorf("%d fetches, want 1", got)
+	}
+}
+
+func TestCachingRateProviderFetchTimeout(t *testing.T) {
+	t.Parallel()
+
+	// a provider that hangs and ignores its context
+	stub := &stubRateProvider{rate: big.NewRat(57, 1), gate: make(chan struct{})}
+	t.Cleanup(func() { close(stub.gate) })
+	rates := NewCachingRateProvider(stub, CacheOptions{TTL: time.Minute, FetchTimeout: 10 * time.Millisecond})
+
+	for range 2 {
+		if _, err := rates.Rate(context.Background(), USD, ETB, time.Time{}); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Rate() error = %v, want %v", err, context.DeadlineExceeded)
+		}
+	}
+	// the timed out fetch no longer holds the pair
+	if got := stub.calls.Load(); got != 2 {
+		t.Errorf("%d fetches, want 2", got)
+	}
+}
+
+func TestChainRateProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errDown := errors.New("rate source down")
+
+	primary := &stubRateProvider{err: errDown}
+	backup := NewStaticRateProvider()
+	_ = backup.SetRateString(USD, ETB, "57.5")
+
+	rate, err := NewChainRateProvider(primary, backup).Rate(ctx, USD, ETB, time.Time{})
+	if err != nil {
+		t.Fatalf("Rate() unexpected error: %v", err)
+	}
+	if rate.Source != "static" || rate.Value.Cmp(big.NewRat(115, 2)) != 0 {
+		t.Errorf("Rate() = %s from %s, want 57.5 from static", rate.Value.RatString(), rate.Source)
+	}
+
+	primary.set(big.NewRat(57, 1), nil)
+	if rate, _ := NewChainRateProvider(primary, backup).Rate(ctx, USD, ETB, time.Time{}); rate.Source != "stub" {
+		t.Errorf("Rate() source = %s, want the first provider", rate.Source)
+	}
+
+	primary.set(nil, errDown)
+	_, err = NewChainRateProvider(primary, backup).Rate(ctx, USD, KES, time.Time{})
+	if !errors.Is(err, errDown) || !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Rate() error = %v, want both provider errors", err)
+	}
+
+	if _, err := NewChainRateProvider().Rate(ctx, USD, KES, time.Time{}); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Rate() error = %v, want %v", err, ErrRateNotFound)
+	}
+}
@@ -0,0 +1,154 @@
+package goodmoney
+
+// CashRounding describes how amounts of a currency are displayed and paid in cash
+// when that differs from its ISO 4217 minor unit, after the CLDR currency data.
+type CashRounding struct {
+	// DisplayDigits is the number of decimals amounts are usually displayed with.
+	DisplayDigits int
+	// CashDigits is the number of decimals of cash amounts.
+	CashDigits int
+	// CashIncrement is the smallest coin in units of 10^-CashDigits,
+	// e.g. 5 with 2 CashDigits for 0.05.
+	CashIncrement int64
+}
+
+// CashRoundingMap holds the currencies whose display or cash precision differs from
+// their minor unit in CurrencyMap. Other currencies display and pay in cash to the
+// minor unit.
+var CashRoundingMap = map[string]CashRounding{
+	// the smallest coin is larger than the minor unit
+	AUD: {DisplayDigits: 2, CashDigits: 2, CashIncrement: 5},
+	CAD: {DisplayDigits: 2, CashDigits: 2, CashIncrement: 5},
+	CHF: {DisplayDigits: 2, CashDigits: 2, CashIncrement: 5},
+	DKK: {DisplayDigits: 2, CashDigits: 2, CashIncrement: 50},
+	NZD: {DisplayDigits: 2, CashDigits: 2, CashIncrement: 10},
+
+	// cash is paid in whole units
+	AMD: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	COP: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	CRC: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	CZK: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	GYD: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	HUF: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	IDR: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	MNT: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	MUR: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	NOK: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	PKR: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	SEK: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	TWD: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	TZS: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+	UZS: {DisplayDigits: 2, CashDigits: 0, CashIncrement: 1},
+
+	// amounts are displayed and paid in whole units
+	AFN: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	ALL: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	IQD: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	IRR: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	KPW: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	LAK: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	LBP: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	MGA: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	MMK: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	RSD: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	SOS: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	SYP: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+	YER: {DisplayDigits: 0, CashDigits: 0, CashIncrement: 1},
+}
+
+// GetCashRounding returns the cash rounding of a currency, its minor unit when it
+// isn't listed in CashRoundingMap.
+// Returns ErrCurrencyCodeDoesNotExist for unknown currencies.
+//
+// Example:
+//
+//	r, _ := GetCashRounding(CHF)  // {DisplayDigits: 2, CashDigits: 2, CashIncrement: 5}
+func GetCashRounding(code string) (CashRounding, error) {
+	c, err := lookupCurrency(code)
+	if err != nil {
+		return CashRounding{}, err
+	}
+	return cashRoundingOf(c), nil
+}
+
+// cashRoundingOf returns the cash rounding of a registered currency.
+func cashRoundingOf(c *currencyEntry) CashRounding {
+	if r, ok := CashRoundingMap[c.code]; ok {
+		return r
+	}
+	return CashRounding{DisplayDigits: c.MinorUnit, CashDigits: c.MinorUnit, CashIncrement: 1}
+}
+
+// cashIncrement returns the smallest coin of m's currency in minor units.
+func (m Money) cashIncrement() int64 {
+	if m.currency == nil {
+		return 1
+	}
+	r := cashRoundingOf(m.currency)
+	exponent := m.currency.MinorUnit - r.CashDigits
+	if exponent < 0 || exponent >= len(pow10) || r.CashIncrement <= 0 {
+		return 1
+	}
+	increment, err := multiplyInt64(r.CashIncrement, pow10[exponent])
+	if err != nil {
+		return 1
+	}
+	return increment
+}
+
+// RoundCash rounds m to an amount payable in cash, a multiple of the smallest coin
+// of its currency, with the given scheme. See CashRoundingMap.
+// Returns ErrOverflow or ErrUnderflow if the rounded amount doesn't fit in int64.
+//
+// Example:
+//
+//	m, _ := NewFromString("12.43", CHF)
+//	cash, _ := m.RoundCash(RoundHalfUp)  // 12.45 CHF
+//
+//	m, _ = NewFromString("99.50", SEK)
+//	cash, _ = m.RoundCash(RoundHalfEven) // 100.00 SEK
+func (m Money) RoundCash(scheme RoundScheme) (*Money, error) {
+	return m.RoundToIncrement(m.cashIncrement(), scheme)
+}
+
+// IsCashRounded returns true if m can be paid in cash, a multiple of the smallest
+// coin of its currency.
+func (m Money) IsCashRounded() bool {
+	return m.amount%m.cashIncrement() == 0
+}
+
+// FormatPrecision selects how many decimals formatters show.
+type FormatPrecision int
+
+const (
+	// PrecisionMinorUnit shows every decimal of the currency's minor unit.
+	PrecisionMinorUnit FormatPrecision = iota
+	// PrecisionDisplay rounds half to even to the currency's usual display digits (e.g., 0 for IQD).
+	PrecisionDisplay
+	// PrecisionCash rounds half up to the smallest coin and shows the cash digits (e.g., 0 for SEK).
+	PrecisionCash
+)
+
+// withPrecision returns m rounded for the given precision and the number of
+// decimals to show. Amounts that can't be rounded are returned unchanged.
+func (m Money) withPrecision(precision FormatPrecision) (Money, int) {
+	digits := m.scale()
+	if m.currency == nil || precision == PrecisionMinorUnit {
+		return m, digits
+	}
+
+	r := cashRoundingOf(m.currency)
+	var rounded *Money
+	var err error
+	if precision == PrecisionCash {
+		rounded, err = m.RoundCash(RoundHalfUp)
+		digits = r.CashDigits
+	} else {
+		rounded, err = m.RoundTo(r.DisplayDigits, RoundHalfEven)
+		digits = r.DisplayDigits
+	}
+	if err != nil {
+		return m, m.scale()
+	}
+	return *rounded, min(digits, m.scale())
+}
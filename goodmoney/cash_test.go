@@ -0,0 +1,140 @@
+package goodmoney
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestCashRoundingMap(t *testing.T) {
+	t.Parallel()
+
+	for code, r := range CashRoundingMap {
+		currency, ok := CurrencyMap[code]
+		if !ok {
+			t.Errorf("%s isn't in CurrencyMap", code)
+			continue
+		}
+		if r.DisplayDigits > currency.MinorUnit || r.CashDigits > r.DisplayDigits || r.CashIncrement <= 0 {
+			t.Errorf("%s cash rounding %+v doesn't fit minor unit %d", code, r, currency.MinorUnit)
+		}
+	}
+}
+
+func TestGetCashRounding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code    string
+		want    CashRounding
+		wantErr error
+	}{
+		{code: CHF, want: CashRounding{DisplayDigits: 2, CashDigits: 2, CashIncrement: 5}},
+		{code: SEK, want: CashRounding{DisplayDigits: 2, CashDigits: 0, CashIncrement: 1}},
+		{code: IQD, want: CashRounding{DisplayDigits: 0, CashDigits: 0, CashIncrement: 1}},
+		{code: KWD, want: CashRounding{DisplayDigits: 3, CashDigits: 3, CashIncrement: 1}},
+		{code: JPY, want: CashRounding{DisplayDigits: 0, CashDigits: 0, CashIncrement: 1}},
+		{code: "XYZ", wantErr: ErrCurrencyCodeDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GetCashRounding(tt.code)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetCashRounding() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetCashRounding(%s) = %+v, want %+v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundCash(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		amount      string
+		currency    string
+		scheme      RoundScheme
+		want        string
+		wantRounded bool
+	}{
+		{name: "CHF up to 0.05", amount: "12.43", currency: CHF, scheme: RoundHalfUp, want: "12.45 CHF"},
+		{name: "CHF down to 0.05", amount: "12.42", currency: CHF, scheme: RoundHalfUp, want: "12.40 CHF"},
+		{name: "CHF already rounded", amount: "12.45", currency: CHF, scheme: RoundHalfUp, want: "12.45 CHF", wantRounded: true},
+		{name: "CAD negative", amount: "-0.03", currency: CAD, scheme: RoundHalfEven, want: "-0.05 CAD"},
+		{name: "DKK tie to even", amount: "10.25", currency: DKK, scheme: RoundHalfEven, want: "10.00 DKK"},
+		{name: "DKK to 0.50", amount: "10.26", currency: DKK, scheme: RoundHalfUp, want: "10.50 DKK"},
+		{name: "NZD to 0.10", amount: "3.14", currency: NZD, scheme: RoundHalfUp, want: "3.10 NZD"},
+		{name: "SEK whole krona", amount: "99.50", currency: SEK, scheme: RoundHalfEven, want: "100.00 SEK"},
+		{name: "HUF whole forint", amount: "1234.49", currency: HUF, scheme: RoundHalfUp, want: "1234.00 HUF"},
+		{name: "CZK whole koruna", amount: "10.00", currency: CZK, scheme: RoundHalfUp, want: "10.00 CZK", wantRounded: true},
+		{name: "USD cents", amount: "12.43", currency: USD, scheme: RoundHalfUp, want: "12.43 USD", wantRounded: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewFromString(tt.amount, tt.currency)
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error: %v", err)
+			}
+			if got := m.IsCashRounded(); got != tt.wantRounded {
+				t.Errorf("IsCashRounded() = %v, want %v", got, tt.wantRounded)
+			}
+
+			got, err := m.RoundCash(tt.scheme)
+			if err != nil {
+				t.Fatalf("RoundCash() unexpected error: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("RoundCash(%v) = %s, want %s", tt.scheme, got, tt.want)
+			}
+			if !got.IsCashRounded() {
+				t.Errorf("RoundCash() = %s isn't cash rounded", got)
+			}
+		})
+	}
+}
+
+func TestFormatPrecision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		amount    string
+		currency  string
+		mode      FormatMode
+		precision FormatPrecision
+		want      string
+	}{
+		{name: "minor unit by default", amount: "1234.567", currency: IQD, mode: FormatCode, want: "1234.567 IQD"},
+		{name: "display digits", amount: "1234.567", currency: IQD, mode: FormatCode, precision: PrecisionDisplay, want: "1235 IQD"},
+		{name: "display digits half even", amount: "1234.500", currency: IQD, mode: FormatMinimal, precision: PrecisionDisplay, want: "1234 ع.د"},
+		{name: "display digits same as minor unit", amount: "12.43", currency: SEK, mode: FormatCode, precision: PrecisionDisplay, want: "12.43 SEK"},
+		{name: "cash digits", amount: "12.50", currency: SEK, mode: FormatMinimal, precision: PrecisionCash, want: "13 kr"},
+		{name: "cash increment", amount: "12.43", currency: CHF, mode: FormatCode, precision: PrecisionCash, want: "12.45 CHF"},
+		{name: "cash standard", amount: "1234.43", currency: CHF, mode: FormatStandard, precision: PrecisionCash, want: "CHF1,234.45"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewFromString(tt.amount, tt.currency)
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error: %v", err)
+			}
+			got := m.FormatWithOptions(FormatOptions{Locale: language.English, Mode: tt.mode, Precision: tt.precision})
+			if got != tt.want {
+				t.Errorf("FormatWithOptions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
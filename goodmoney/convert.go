@@ -0,0 +1,222 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrRateNotFound happens when a RateProvider has no rate for a currency pair.
+	ErrRateNotFound = errors.New("exchange rate not found")
+
+	// ErrInvalidRate happens when an exchange rate is missing, zero or negative.
+	ErrInvalidRate = errors.New("invalid exchange rate")
+)
+
+// Rate is an exchange rate between two ISO 4217 currencies:
+// one unit of Base is worth Value units of Quote.
+type Rate struct {
+	Base  string
+	Quote string
+	// Value is the exact rate. It must not be modified once the Rate is returned.
+	Value *big.Rat
+	// Time is when the rate was observed or became valid, zero if unknown.
+	Time time.Time
+	// Source names the provider the rate comes from (e.g., "static", "ECB").
+	Source string
+	// Inverted is true if the rate, or one leg of a cross rate, was derived by
+	// inverting a Quote/Base quote.
+	Inverted bool
+	// Path lists the currencies a cross rate went through, from Base to Quote
+	// (e.g., ETB, EUR, KES). It is empty for rates quoted directly.
+	Path []string
+}
+
+// RateProvider provides exchange rates for currency pairs.
+// A zero at asks for the latest rate, otherwise the rate valid at that point in time.
+type RateProvider interface {
+	Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error)
+}
+
+// Converter converts Money between currencies using the rates of a RateProvider.
+// The converted amount is computed exactly and rounded once to the target
+// currency's minor unit with the converter's RoundScheme.
+type Converter struct {
+	provider RateProvider
+	scheme   RoundScheme
+	now      func() time.Time
+}
+
+// NewConverter returns a Converter that uses provider for rates and rounds
+// converted amounts with scheme.
+//
+// Example:
+//
+//	rates := NewStaticRateProvider()
+//	_ = rates.SetRateString(USD, ETB, "57.3122")
+//	converter := NewConverter(rates, RoundHalfEven)
+func NewConverter(provider RateProvider, scheme RoundScheme) *Converter {
+	return &Converter{
+		provider: provider,
+		scheme:   scheme,
+		now:      time.Now,
+	}
+}
+
+// WithClock returns a copy of c that takes the time of its conversion records from
+// now instead of time.Now, such as a fixed clock in tests.
+func (c *Converter) WithClock(now func() time.Time) *Converter {
+	clocked := *c
+	clocked.now = now
+	return &clocked
+}
+
+// Convert converts m into the currency to at the latest rate.
+// Converting into the same currency returns a copy of m without asking for a rate.
+//
+// Example:
+//
+//	etb, err := converter.Convert(ctx, usd, ETB)
+func (c *Converter) Convert(ctx context.Context, m *Money, to string) (*Money, error) {
+	return c.ConvertAt(ctx, m, to, time.Time{})
+}
+
+// ConvertAt converts m into the currency to at the rate valid at the given time.
+func (c *Converter) ConvertAt(ctx context.Context, m *Money, to string, at time.Time) (*Money, error) {
+	converted, _, err := c.convert(ctx, m, to, at)
+	return converted, err
+}
+
+// ConvertWithRate converts m into the currency to at the rate valid at the given time
+// and also returns the rate that was used, including its Path for cross rates.
+// The returned Rate is the zero Rate when m is already in the currency to.
+func (c *Converter) ConvertWithRate(ctx context.Context, m *Money, to string, at time.Time) (*Money, Rate, error) {
+	converted, rate, err := c.convert(ctx, m, to, at)
+	if err != nil || rate == nil {
+		return converted, Rate{}, err
+	}
+	return converted, *rate, nil
+}
+
+// convert converts m and also returns the rate used, nil when no conversion was needed.
+func (c *Converter) convert(ctx context.Context, m *Money, to string, at time.Time) (*Money, *Rate, error) {
+	if m == nil || m.currency == nil {
+		return nil, nil, ErrCurrencyMismatch
+	}
+	target, err := lookupCurrency(to)
+	if err != nil {
+		return nil, nil, err
+	}
+	if target.sameAs(m.currency) {
+		return &Money{amount: m.amount, currency: m.currency}, nil, nil
+	}
+
+	rate, err := c.rate(ctx, m.currency.code, to, at)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	units, err := convertUnits(m.amount, m.currency, target, rate.Value, c.scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Money{amount: units, currency: target}, &rate, nil
+}
+
+// rate asks the provider for the base/quote rate and checks it is positive.
+func (c *Converter) rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	rate, err := c.provider.Rate(ctx, base, quote, at)
+	if err != nil {
+		return Rate{}, err
+	}
+	if rate.Value == nil || rate.Value.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("%w: %s", ErrInvalidRate, pairKey(base, quote))
+	}
+	return rate, nil
+}
+
+// exactConversion returns the exact converted amount in minor units of to.
+func exactConversion(amount int64, from, to *currencyEntry, rate *big.Rat) *big.Rat {
+	exact := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(amount), bigPow10(to.MinorUnit)),
+		bigPow10(from.MinorUnit),
+	)
+	return exact.Mul(exact, rate)
+}
+
+// convertUnits converts amount minor units of from into minor units of to, rounded with scheme.
+func convertUnits(amount int64, from, to *currencyEntry, rate *big.Rat, scheme RoundScheme) (int64, error) {
+	units := roundRat(exactConversion(amount, from, to, rate), scheme)
+	if !units.IsInt64() {
+		return 0, overflowError(units.Sign() < 0)
+	}
+	return units.Int64(), nil
+}
+
+// pairKey returns the "BASE/QUOTE" key of a currency pair.
+func pairKey(base, quote string) string {
+	return base + "/" + quote
+}
+
+// StaticRateProvider is an in-memory RateProvider with fixed rates that ignores
+// the requested time. Missing pairs are answered with the inverse of the opposite
+// pair when it is set. It is safe for concurrent use.
+type StaticRateProvider struct {
+	mu    sync.RWMutex
+	rates map[string]*big.Rat
+}
+
+// NewStaticRateProvider returns an empty StaticRateProvider.
+func NewStaticRateProvider() *StaticRateProvider {
+	return &StaticRateProvider{
+		rates: make(map[string]*big.Rat),
+	}
+}
+
+// SetRate sets the rate of the base/quote pair: one base is worth rate quote.
+// Returns ErrCurrencyCodeDoesNotExist for unknown currencies and ErrInvalidRate
+// if rate isn't positive.
+func (p *StaticRateProvider) SetRate(base, quote string, rate *big.Rat) error {
+	if !ValidateCurrency(base) || !ValidateCurrency(quote) {
+		return ErrCurrencyCodeDoesNotExist
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return ErrInvalidRate
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rates[pairKey(base, quote)] = new(big.Rat).Set(rate)
+	return nil
+}
+
+// SetRateString sets the rate of the base/quote pair from a decimal ("57.3122")
+// or fraction ("1/3") string.
+func (p *StaticRateProvider) SetRateString(base, quote, rate string) error {
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok {
+		return ErrInvalidRate
+	}
+	return p.SetRate(base, quote, r)
+}
+
+// Rate implements RateProvider.
+func (p *StaticRateProvider) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	if base == quote {
+		return Rate{Base: base, Quote: quote, Value: big.NewRat(1, 1), Source: "static"}, nil
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if r, ok := p.rates[pairKey(base, quote)]; ok {
+		return Rate{Base: base, Quote: quote, Value: r, Source: "static"}, nil
+	}
+	if r, ok := p.rates[pairKey(quote, base)]; ok {
+		return Rate{Base: base, Quote: quote, Value: new(big.Rat).Inv(r), Source: "static", Inverted: true}, nil
+	}
+	return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, pairKey(base, quote))
+}
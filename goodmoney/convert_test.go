@@ -0,0 +1,132 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestStaticRateProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := NewStaticRateProvider()
+	if err := p.SetRateString(EUR, USD, "1.0823"); err != nil {
+		t.Fatalf("SetRateString() unexpected error: %v", err)
+	}
+
+	rate, err := p.Rate(ctx, EUR, USD, time.Time{})
+	if err != nil {
+		t.Fatalf("Rate() unexpected error: %v", err)
+	}
+	if rate.Value.Cmp(big.NewRat(10823, 10000)) != 0 || rate.Inverted || rate.Base != EUR || rate.Quote != USD {
+		t.Errorf("Rate(EUR/USD) = %+v", rate)
+	}
+
+	inverse, err := p.Rate(ctx, USD, EUR, time.Time{})
+	if err != nil {
+		t.Fatalf("Rate() unexpected error: %v", err)
+	}
+	if inverse.Value.Cmp(big.NewRat(10000, 10823)) != 0 || !inverse.Inverted {
+		t.Errorf("Rate(USD/EUR) = %+v, want inverted 10000/10823", inverse)
+	}
+
+	same, err := p.Rate(ctx, USD, USD, time.Time{})
+	if err != nil || same.Value.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Errorf("Rate(USD/USD) = %+v, %v, want 1", same, err)
+	}
+
+	if _, err := p.Rate(ctx, USD, ETB, time.Time{}); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Rate(USD/ETB) error = %v, want %v", err, ErrRateNotFound)
+	}
+	if err := p.SetRateString(USD, "INVALID", "1"); err != ErrCurrencyCodeDoesNotExist {
+		t.Errorf("SetRateString() error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+	if err := p.SetRateString(USD, ETB, "0"); err != ErrInvalidRate {
+		t.Errorf("SetRateString() error = %v, want %v", err, ErrInvalidRate)
+	}
+	if err := p.SetRateString(USD, ETB, "abc"); err != ErrInvalidRate {
+		t.Errorf("SetRateString() error = %v, want %v", err, ErrInvalidRate)
+	}
+	if err := p.SetRate(USD, ETB, nil); err != ErrInvalidRate {
+		t.Errorf("SetRate() error = %v, want %v", err, ErrInvalidRate)
+	}
+}
+
+func TestConverterConvert(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := NewStaticRateProvider()
+	_ = p.SetRateString(USD, ETB, "57.3122")
+	_ = p.SetRateString(USD, JPY, "151.237")
+	_ = p.SetRateString(BHD, USD, "2.6596")
+
+	tests := []struct {
+		name    string
+		amount  string
+		from    string
+		to      string
+		scheme  RoundScheme
+		want    string
+		wantErr error
+	}{
+		{name: "USD to ETB", amount: "100.00", from: USD, to: ETB, scheme: RoundHalfEven, want: "5731.22 ETB"},
+		{name: "USD to ETB rounds half up", amount: "0.25", from: USD, to: ETB, scheme: RoundHalfUp, want: "14.33 ETB"},
+		{name: "USD to ETB truncates", amount: "0.25", from: USD, to: ETB, scheme: RoundTowardZero, want: "14.32 ETB"},
+		{name: "negative USD to ETB", amount: "-0.25", from: USD, to: ETB, scheme: RoundTowardZero, want: "-14.32 ETB"},
+		{name: "USD to JPY", amount: "10.00", from: USD, to: JPY, scheme: RoundHalfEven, want: "1512 JPY"},
+		{name: "JPY to USD uses the inverse", amount: "1512", from: JPY, to: USD, scheme: RoundHalfEven, want: "10.00 USD"},
+		{name: "BHD to USD", amount: "1.005", from: BHD, to: USD, scheme: RoundHalfEven, want: "2.67 USD"},
+		{name: "same currency", amount: "1.23", from: USD, to: USD, scheme: RoundHalfEven, want: "1.23 USD"},
+		{name: "missing rate", amount: "1.00", from: EUR, to: ETB, scheme: RoundHalfEven, wantErr: ErrRateNotFound},
+		{name: "unknown target", amount: "1.00", from: USD, to: "INVALID", scheme: RoundHalfEven, wantErr: ErrCurrencyCodeDoesNotExist},
+		{name: "overflow", amount: "92233720368547758.07", from: USD, to: ETB, scheme: RoundHalfEven, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewFromString(tt.amount, tt.from)
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error: %v", err)
+			}
+
+			got, err := NewConverter(p, tt.scheme).Convert(ctx, m, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Convert() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Convert() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type brokenRateProvider struct{}
+
+func (brokenRateProvider) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	return Rate{Base: base, Quote: quote, Value: new(big.Rat)}, nil
+}
+
+func TestConverterInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := NewConverter(brokenRateProvider{}, RoundHalfEven)
+	m, _ := NewFromMinorUnits(100, USD)
+
+	if _, err := c.Convert(ctx, m, EUR); !errors.Is(err, ErrInvalidRate) {
+		t.Errorf("Convert() error = %v, want %v", err, ErrInvalidRate)
+	}
+	if _, err := c.Convert(ctx, nil, EUR); err != ErrCurrencyMismatch {
+		t.Errorf("Convert(nil) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+}
@@ -0,0 +1,138 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// CrossRateProvider derives rates between arbitrary currencies from a RateProvider
+// that only quotes some pairs, such as a feed quoting everything against EUR or USD.
+//
+// A direct quote is always preferred. Otherwise the shortest chain through the
+// configured pivot currencies is used, trying pivots in the order they were given:
+// ETB→EUR→KES before ETB→EUR→USD→KES. Cross rates are the exact product of their
+// legs, nothing is rounded until the converted amount is. The returned Rate reports
+// the chain in Path, the oldest leg's Time and the legs' Source.
+type CrossRateProvider struct {
+	provider RateProvider
+	pivots   []string
+}
+
+// NewCrossRateProvider returns a CrossRateProvider over provider using the given pivot currencies.
+//
+// Example:
+//
+//	rates := NewCrossRateProvider(ecbRates, EUR, USD)
+//	converter := NewConverter(rates, RoundHalfEven)
+func NewCrossRateProvider(provider RateProvider, pivots ...string) *CrossRateProvider {
+	return &CrossRateProvider{
+		provider: provider,
+		pivots:   append([]string(nil), pivots...),
+	}
+}
+
+// Rate implements RateProvider.
+func (p *CrossRateProvider) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	direct, err := p.provider.Rate(ctx, base, quote, at)
+	if err == nil {
+		return direct, nil
+	}
+	if !errors.Is(err, ErrRateNotFound) {
+		return Rate{}, err
+	}
+
+	// legs caches the answer for every pair asked during this lookup, nil if not found
+	legs := map[string]*Rate{}
+	leg := func(from, to string) (*Rate, error) {
+		key := pairKey(from, to)
+		if r, ok := legs[key]; ok {
+			return r, nil
+		}
+		r, err := p.provider.Rate(ctx, from, to, at)
+		if errors.Is(err, ErrRateNotFound) {
+			legs[key] = nil
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		legs[key] = &r
+		return &r, nil
+	}
+
+	// breadth-first search over the pivots, so the first chain found is the shortest
+	type chain struct {
+		path  []string
+		rates []*Rate
+	}
+	frontier := []chain{{path: []string{base}}}
+	visited := map[string]bool{base: true, quote: true}
+	for len(frontier) > 0 {
+		var next []chain
+		for _, c := range frontier {
+			last := c.path[len(c.path)-1]
+			if len(c.path) > 1 {
+				r, err := leg(last, quote)
+				if err != nil {
+					return Rate{}, err
+				}
+				if r != nil {
+					return crossRate(base, quote, append(c.path, quote), append(c.rates, r))
+				}
+			}
+			for _, pivot := range p.pivots {
+				if visited[pivot] {
+					continue
+				}
+				r, err := leg(last, pivot)
+				if err != nil {
+					return Rate{}, err
+				}
+				if r == nil {
+					continue
+				}
+				visited[pivot] = true
+				next = append(next, chain{
+					path:  append(append([]string(nil), c.path...), pivot),
+					rates: append(append([]*Rate(nil), c.rates...), r),
+				})
+			}
+		}
+		frontier = next
+	}
+
+	return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, pairKey(base, quote))
+}
+
+// crossRate multiplies the legs of a chain into a single Rate, path[i]→path[i+1]
+// being the pair of legs[i]. Returns ErrInvalidRate if a leg isn't positive.
+func crossRate(base, quote string, path []string, legs []*Rate) (Rate, error) {
+	result := Rate{
+		Base:  base,
+		Quote: quote,
+		Value: big.NewRat(1, 1),
+		Path:  path,
+	}
+
+	var sources []string
+	for i, l := range legs {
+		if l.Value == nil || l.Value.Sign() <= 0 {
+			return Rate{}, fmt.Errorf("%w: %s in the chain for %s", ErrInvalidRate, pairKey(path[i], path[i+1]), pairKey(base, quote))
+		}
+		result.Value.Mul(result.Value, l.Value)
+		result.Inverted = result.Inverted || l.Inverted
+		if result.Time.IsZero() || (!l.Time.IsZero() && l.Time.Before(result.Time)) {
+			result.Time = l.Time
+		}
+		if l.Source != "" && (len(sources) == 0 || sources[len(sources)-1] != l.Source) {
+			sources = append(sources, l.Source)
+		}
+	}
+	result.Source = strings.Join(sources, "+")
+
+	return result, nil
+}
@@ -0,0 +1,245 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mapRateProvider quotes only the pairs it holds, with their time and source, and counts lookups.
+type mapRateProvider struct {
+	rates map[string]Rate
+	err   error
+	calls int
+}
+
+func (p *mapRateProvider) set(base, quote, value string, at time.Time, source string) {
+	v, _ := new(big.Rat).SetString(value)
+	if p.rates == nil {
+		p.rates = map[string]Rate{}
+	}
+	p.rates[pairKey(base, quote)] = Rate{Base: base, Quote: quote, Value: v, Time: at, Source: source}
+}
+
+func (p *mapRateProvider) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	p.calls++
+	if p.err != nil {
+		return Rate{}, p.err
+	}
+	if r, ok := p.rates[pairKey(base, quote)]; ok {
+		return r, nil
+	}
+	return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, pairKey(base, quote))
+}
+
+func TestCrossRateProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	day1 := time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	eurQuotes := NewStaticRateProvider()
+	_ = eurQuotes.SetRateString(EUR, ETB, "62.5")
+	_ = eurQuotes.SetRateString(EUR, KES, "140")
+	_ = eurQuotes.SetRateString(ETB, KES, "2.5")
+
+	eurOnly := NewStaticRateProvider()
+	_ = eurOnly.SetRateString(EUR, ETB, "62.5")
+	_ = eurOnly.SetRateString(EUR, KES, "140")
+
+	mixedQuotes := NewStaticRateProvider()
+	_ = mixedQuotes.SetRateString(USD, ETB, "57.5")
+	_ = mixedQuotes.SetRateString(EUR, USD, "1.08")
+	_ = mixedQuotes.SetRateString(EUR, KES, "140")
+
+	dated := &mapRateProvider{}
+	dated.set(EUR, USD, "1.08", day1, "ECB")
+	dated.set(USD, ETB, "57.5", day2, "bank")
+
+	product := func(rs ...*big.Rat) *big.Rat {
+		result := big.NewRat(1, 1)
+		for _, r := range rs {
+			result.Mul(result, r)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name       string
+		provider   RateProvider
+		pivots     []string
+		base       string
+		quote      string
+		wantValue  *big.Rat
+		wantPath   []string
+		wantInvert bool
+		wantTime   time.Time
+		wantSource string
+		wantErr    error
+	}{
+		{
+			name:       "direct quote is preferred",
+			provider:   eurQuotes,
+			pivots:     []string{EUR},
+			base:       ETB,
+			quote:      KES,
+			wantValue:  big.NewRat(5, 2),
+			wantSource: "static",
+		},
+		{
+			name:       "one pivot with an inverted leg",
+			provider:   eurOnly,
+			pivots:     []string{USD, EUR},
+			base:       KES,
+			quote:      ETB,
+			wantValue:  product(big.NewRat(1, 140), big.NewRat(125, 2)),
+			wantPath:   []string{KES, EUR, ETB},
+			wantInvert: true,
+			wantSource: "static",
+		},
+		{
+			name:       "chain through two pivots",
+			provider:   mixedQuotes,
+			pivots:     []string{EUR, USD},
+			base:       ETB,
+			quote:      KES,
+			wantValue:  product(big.NewRat(2, 115), big.NewRat(100, 108), big.NewRat(140, 1)),
+			wantPath:   []string{ETB, USD, EUR, KES},
+			wantInvert: true,
+			wantSource: "static",
+		},
+		{
+			name:       "oldest leg time and joined sources",
+			provider:   dated,
+			pivots:     []string{USD},
+			base:       EUR,
+			quote:      ETB,
+			wantValue:  product(big.NewRat(108, 100), big.NewRat(115, 2)),
+			wantPath:   []string{EUR, USD, ETB},
+			wantTime:   day1,
+			wantSource: "ECB+bank",
+		},
+		{
+			name:     "pivot without the needed legs",
+			provider: dated,
+			pivots:   []string{USD},
+			base:     ETB,
+			quote:    EUR,
+			wantErr:  ErrRateNotFound,
+		},
+		{
+			name:     "no pivots",
+			provider: eurQuotes,
+			base:     USD,
+			quote:    KES,
+			wantErr:  ErrRateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewCrossRateProvider(tt.provider, tt.pivots...).Rate(ctx, tt.base, tt.quote, time.Time{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Value.Cmp(tt.wantValue) != 0 {
+				t.Errorf("Rate() value = %s, want %s", got.Value, tt.wantValue)
+			}
+			if !reflect.DeepEqual(got.Path, tt.wantPath) {
+				t.Errorf("Rate() path = %v, want %v", got.Path, tt.wantPath)
+			}
+			if got.Inverted != tt.wantInvert {
+				t.Errorf("Rate() inverted = %v, want %v", got.Inverted, tt.wantInvert)
+			}
+			if !got.Time.Equal(tt.wantTime) {
+				t.Errorf("Rate() time = %v, want %v", got.Time, tt.wantTime)
+			}
+			if got.Source != tt.wantSource {
+				t.Errorf("Rate() source = %q, want %q", got.Source, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestCrossRateProviderError(t *testing.T) {
+	t.Parallel()
+
+	down := errors.New("feed is down")
+	p := NewCrossRateProvider(&mapRateProvider{err: down}, EUR)
+	if _, err := p.Rate(context.Background(), ETB, KES, time.Time{}); err != down {
+		t.Errorf("Rate() error = %v, want %v", err, down)
+	}
+}
+
+func TestCrossRateProviderInvalidLeg(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value *big.Rat
+	}{
+		{name: "nil", value: nil},
+		{name: "zero", value: new(big.Rat)},
+		{name: "negative", value: big.NewRat(-140, 1)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed := &mapRateProvider{}
+			feed.set(ETB, EUR, "0.016", time.Time{}, "")
+			feed.rates[pairKey(EUR, KES)] = Rate{Base: EUR, Quote: KES, Value: tt.value}
+
+			_, err := NewCrossRateProvider(feed, EUR).Rate(context.Background(), ETB, KES, time.Time{})
+			if !errors.Is(err, ErrInvalidRate) {
+				t.Fatalf("Rate() error = %v, want %v", err, ErrInvalidRate)
+			}
+			if !strings.Contains(err.Error(), "EUR/KES") {
+				t.Errorf("Rate() error = %q, want it to name EUR/KES", err)
+			}
+		})
+	}
+}
+
+func TestConvertThroughCrossRate(t *testing.T) {
+	t.Parallel()
+
+	eurQuotes := NewStaticRateProvider()
+	_ = eurQuotes.SetRateString(EUR, ETB, "62.5")
+	_ = eurQuotes.SetRateString(EUR, KES, "140")
+
+	converter := NewConverter(NewCrossRateProvider(eurQuotes, EUR), RoundHalfEven)
+	etb, _ := NewFromString("1000.00", ETB)
+
+	kes, rate, err := converter.ConvertWithRate(context.Background(), etb, KES, time.Time{})
+	if err != nil {
+		t.Fatalf("ConvertWithRate() unexpected error: %v", err)
+	}
+	// 1000 / 62.5 * 140 = 2240 exactly, no intermediate rounding
+	if kes.String() != "2240.00 KES" {
+		t.Errorf("ConvertWithRate() = %s, want 2240.00 KES", kes)
+	}
+	if !reflect.DeepEqual(rate.Path, []string{ETB, EUR, KES}) {
+		t.Errorf("ConvertWithRate() path = %v, want [ETB EUR KES]", rate.Path)
+	}
+
+	// no rate is needed for the same currency
+	same, rate, err := converter.ConvertWithRate(context.Background(), etb, ETB, time.Time{})
+	if err != nil || *same != *etb || rate.Value != nil {
+		t.Errorf("ConvertWithRate(ETB) = %s, %+v, %v", same, rate, err)
+	}
+}
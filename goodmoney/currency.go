@@ -1,6 +1,9 @@
 package goodmoney
 
-import "errors"
+import (
+	"errors"
+	"sync"
+)
 
 var (
 	// ErrCurrencyDoesNotExist happens when the provided currency code does not exist
@@ -15,6 +18,56 @@ type Currency struct {
 	SymbolPosition bool   // true = before amount, false = after amount. Defaults to true.
 }
 
+// currencyEntry is the registry record a Money points to. Entries are interned,
+// one per definition of a currency code, so Money carries a pointer instead of a
+// private copy and resolves its code without a map lookup. Two Money share a
+// currency when their entries have the same code, see sameAs.
+type currencyEntry struct {
+	Currency
+	code string
+}
+
+// sameAs reports whether c and other are the same currency. Entries are compared by
+// code, so that Money created before and after an edit of CurrencyMap still match;
+// the pointers are compared first as they are equal in all other cases.
+func (c *currencyEntry) sameAs(other *currencyEntry) bool {
+	if c == other {
+		return true
+	}
+	return c != nil && other != nil && c.code == other.code
+}
+
+var (
+	// currencies interns the entry of every code looked up so far. Reads don't lock,
+	// currenciesMu serializes interning.
+	currencies   sync.Map // code -> *currencyEntry
+	currenciesMu sync.Mutex
+)
+
+// lookupCurrency returns the interned registry entry for code, interning it on first
+// use. CurrencyMap is checked on every lookup, so a code deleted from it is rejected
+// and an edited entry is interned anew for the values created afterwards. Values
+// created before the edit keep the definition they were created with and still
+// match the new ones, as currencies are compared by code.
+func lookupCurrency(code string) (*currencyEntry, error) {
+	currency, ok := CurrencyMap[code]
+	if !ok {
+		return nil, ErrCurrencyCodeDoesNotExist
+	}
+	if entry, ok := currencies.Load(code); ok && entry.(*currencyEntry).Currency == currency {
+		return entry.(*currencyEntry), nil
+	}
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	if entry, ok := currencies.Load(code); ok && entry.(*currencyEntry).Currency == currency {
+		return entry.(*currencyEntry), nil
+	}
+	entry := &currencyEntry{Currency: currency, code: code}
+	currencies.Store(code, entry)
+	return entry, nil
+}
+
 // retrive currency by code
 func getCurrency(code string) (Currency, error) {
 	res, ok := CurrencyMap[code]
@@ -191,3 +191,129 @@ func TestGetCurrencyByNumericCodeCoverage(t *testing.T) {
 		}
 	}
 }
+
+func TestLookupCurrencyInterned(t *testing.T) {
+	t.Parallel()
+
+	first, err := lookupCurrency(USD)
+	if err != nil {
+		t.Fatalf("lookupCurrency() unexpected error: %v", err)
+	}
+	second, err := lookupCurrency(USD)
+	if err != nil {
+		t.Fatalf("lookupCurrency() unexpected error: %v", err)
+	}
+	if first != second {
+		t.Error("lookupCurrency() returned different entries for the same code")
+	}
+	if first.code != USD || first.NumericCode != "840" || first.MinorUnit != 2 {
+		t.Errorf("lookupCurrency() = %+v, want USD/840/2", first)
+	}
+
+	if _, err := lookupCurrency("INVALID"); err != ErrCurrencyCodeDoesNotExist {
+		t.Errorf("lookupCurrency() error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+
+	m1 := MustNew(10, USD)
+	m2, _ := NewFromMinorUnits(1000, USD)
+	if *m1 != *m2 {
+		t.Error("Money values with the same amount and currency are not ==")
+	}
+}
+
+// Not parallel: it temporarily writes to CurrencyMap.
+func TestLookupCurrencyLateRegistration(t *testing.T) {
+	const code = "XLT"
+	CurrencyMap[code] = Currency{NumericCode: "000", MinorUnit: 4}
+	defer func() {
+		delete(CurrencyMap, code)
+		currencies.Delete(code)
+	}()
+
+	m, err := NewFromString("1.2345", code)
+	if err != nil {
+		t.Fatalf("NewFromString() unexpected error: %v", err)
+	}
+	if m.Currency() != code || m.MinorUnits() != 12345 {
+		t.Errorf("NewFromString() = %s, want 1.2345 %s", m, code)
+	}
+
+	other, _ := NewFromMinorUnits(1, code)
+	if m.currency != other.currency {
+		t.Error("late currencies are not interned")
+	}
+}
+
+// Not parallel: it temporarily writes to CurrencyMap.
+func TestLookupCurrencyFollowsCurrencyMap(t *testing.T) {
+	const code = "XED"
+	CurrencyMap[code] = Currency{NumericCode: "000", MinorUnit: 2, Symbol: "X", SymbolPosition: true}
+	defer func() {
+		delete(CurrencyMap, code)
+		currencies.Delete(code)
+	}()
+
+	before, err := NewFromMinorUnits(100, code)
+	if err != nil {
+		t.Fatalf("NewFromMinorUnits() unexpected error: %v", err)
+	}
+
+	// an edited entry applies to the values created afterwards
+	CurrencyMap[code] = Currency{NumericCode: "000", MinorUnit: 3, Symbol: "X", SymbolPosition: true}
+	after, err := NewFromMinorUnits(100, code)
+	if err != nil {
+		t.Fatalf("NewFromMinorUnits() unexpected error: %v", err)
+	}
+	if before.DecimalString() != "1.00" || after.DecimalString() != "0.100" {
+		t.Errorf("DecimalString() = %s and %s, want 1.00 and 0.100", before.DecimalString(), after.DecimalString())
+	}
+	if again, _ := NewFromMinorUnits(1, code); again.currency != after.currency {
+		t.Error("edited currencies are not interned")
+	}
+	// values created before and after the edit are still the same currency
+	if _, err := before.Compare(after); err != nil {
+		t.Errorf("Compare() across the edit unexpected error: %v", err)
+	}
+	if sum, err := before.Plus(*after); err != nil || sum.Currency() != code {
+		t.Errorf("Plus() across the edit = %v, %v, want %s", sum, err, code)
+	}
+	bag, err := NewBag(before, after)
+	if err != nil {
+		t.Fatalf("NewBag() unexpected error: %v", err)
+	}
+	if bag.Len() != 1 {
+		t.Errorf("Bag holds %d balances of %s, want 1", bag.Len(), code)
+	}
+	// extra digits are added to the minor unit the value was created with
+	if p, err := before.Precise(2); err != nil || p.DecimalString() != "1.0000" {
+		t.Errorf("Precise(2) = %v, %v, want 1.0000", p, err)
+	}
+
+	// a deleted code is rejected like ValidateCurrency does
+	delete(CurrencyMap, code)
+	if _, err := NewFromString("1.00", code); err != ErrCurrencyCodeDoesNotExist {
+		t.Errorf("NewFromString() of a deleted code error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+}
+
+func TestCurrencyReferenceAllocations(t *testing.T) {
+	m, _ := NewFromMinorUnits(10050, USD)
+
+	tests := []struct {
+		name string
+		f    func()
+		want float64
+	}{
+		// only the returned *Money
+		{name: "New", f: func() { _, _ = New(100.50, USD) }, want: 1},
+		{name: "NewFromMinorUnits", f: func() { _, _ = NewFromMinorUnits(10050, USD) }, want: 1},
+		{name: "Currency", f: func() { _ = m.Currency() }, want: 0},
+		// only the returned string
+		{name: "String", f: func() { _ = m.String() }, want: 1},
+	}
+	for _, tt := range tests {
+		if got := testing.AllocsPerRun(100, tt.f); got != tt.want {
+			t.Errorf("%s allocates %v times, want %v", tt.name, got, tt.want)
+		}
+	}
+}
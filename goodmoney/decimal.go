@@ -0,0 +1,129 @@
+package goodmoney
+
+import (
+	"math"
+	"strconv"
+)
+
+// parseDecimal parses a plain decimal number such as "-1234.50" into an integer
+// count of 10^-scale units. It accepts an optional leading sign, digits and an
+// optional fractional part; exponents, separators and whitespace are rejected.
+// Trailing zeros beyond scale are allowed, any other extra digit is reported as
+// ErrTooManyDecimalPlaces.
+func parseDecimal[T ~string | ~[]byte](s T, scale int) (int64, error) {
+	if len(s) == 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	neg := false
+	i := 0
+	switch s[0] {
+	case '-':
+		neg = true
+		i++
+	case '+':
+		i++
+	}
+
+	// split into integer and fractional digits
+	intStart := i
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	intEnd := i
+	fracStart, fracEnd := i, i
+	if i < len(s) && s[i] == '.' {
+		i++
+		fracStart = i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		fracEnd = i
+		if fracStart == fracEnd {
+			return 0, ErrInvalidAmount
+		}
+	}
+	if i != len(s) || intStart == intEnd {
+		return 0, ErrInvalidAmount
+	}
+
+	// ignore trailing zeros, they don't add precision
+	significantEnd := fracEnd
+	for significantEnd > fracStart && s[significantEnd-1] == '0' {
+		significantEnd--
+	}
+	if significantEnd-fracStart > scale {
+		return 0, ErrTooManyDecimalPlaces
+	}
+
+	// accumulate the magnitude, allowing one extra unit for math.MinInt64
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+	var units uint64
+	digit := func(d uint64) bool {
+		if units > (limit-d)/10 {
+			return false
+		}
+		units = units*10 + d
+		return true
+	}
+	for j := intStart; j < intEnd; j++ {
+		if !digit(uint64(s[j] - '0')) {
+			return 0, overflowError(neg)
+		}
+	}
+	for j := 0; j < scale; j++ {
+		var d uint64
+		if fracStart+j < significantEnd {
+			d = uint64(s[fracStart+j] - '0')
+		}
+		if !digit(d) {
+			return 0, overflowError(neg)
+		}
+	}
+
+	if neg {
+		// 1<<63 wraps to math.MinInt64 which is exactly what we want
+		return -int64(units), nil
+	}
+	return int64(units), nil
+}
+
+// overflowError returns ErrUnderflow for negative values and ErrOverflow otherwise.
+func overflowError(negative bool) error {
+	if negative {
+		return ErrUnderflow
+	}
+	return ErrOverflow
+}
+
+// appendDecimal appends units scaled by 10^-scale as a plain decimal number,
+// always printing exactly scale fractional digits (e.g., -5 at scale 2 is "-0.05").
+func appendDecimal(dst []byte, units int64, scale int) []byte {
+	magnitude := uint64(units)
+	if units < 0 {
+		dst = append(dst, '-')
+		magnitude = -magnitude
+	}
+
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], magnitude, 10)
+	if scale <= 0 {
+		return append(dst, digits...)
+	}
+
+	if len(digits) <= scale {
+		dst = append(dst, '0', '.')
+		for i := len(digits); i < scale; i++ {
+			dst = append(dst, '0')
+		}
+		return append(dst, digits...)
+	}
+
+	split := len(digits) - scale
+	dst = append(dst, digits[:split]...)
+	dst = append(dst, '.')
+	return append(dst, digits[split:]...)
+}
@@ -0,0 +1,130 @@
+package goodmoney
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Factor is an exact rational factor num/den to scale Money by, such as an exchange
+// rate, a ratio or an interest factor. Factors are kept in lowest terms with a
+// positive denominator. The zero Factor is invalid, build one with NewFactor,
+// ParseFactor or FactorFromFloat.
+type Factor struct {
+	num int64
+	den int64
+}
+
+// NewFactor returns the factor num/den.
+// Returns ErrDivisionByZero if den is zero.
+//
+// Example:
+//
+//	threeQuarters, _ := NewFactor(3, 4)
+func NewFactor(num, den int64) (Factor, error) {
+	if den == 0 {
+		return Factor{}, ErrDivisionByZero
+	}
+	return reduceFactor(big.NewInt(num), big.NewInt(den))
+}
+
+// ParseFactor parses a decimal ("1.0825", "-0.5") or fraction ("3/4") factor.
+// Returns ErrInvalidAmount for malformed input, ErrDivisionByZero for a zero
+// denominator and ErrPrecisionLoss if the factor doesn't fit in int64 terms.
+func ParseFactor(s string) (Factor, error) {
+	if n, d, ok := strings.Cut(s, "/"); ok {
+		num, err1 := strconv.ParseInt(n, 10, 64)
+		den, err2 := strconv.ParseInt(d, 10, 64)
+		if err1 != nil || err2 != nil {
+			return Factor{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+		}
+		return NewFactor(num, den)
+	}
+
+	// every fractional digit counts, trailing zeros only cost a reduction
+	scale := 0
+	if _, fraction, ok := strings.Cut(s, "."); ok {
+		scale = len(fraction)
+	}
+	if scale >= len(pow10) {
+		return Factor{}, fmt.Errorf("%w: %q", ErrPrecisionLoss, s)
+	}
+	num, err := parseDecimal(s, scale)
+	if err != nil {
+		if err == ErrOverflow || err == ErrUnderflow {
+			return Factor{}, fmt.Errorf("%w: %q", ErrPrecisionLoss, s)
+		}
+		return Factor{}, fmt.Errorf("%w: %q", err, s)
+	}
+	return reduceFactor(big.NewInt(num), big.NewInt(pow10[scale]))
+}
+
+// FactorFromFloat returns the factor with the shortest decimal representation of f,
+// so 1.05 is 105/100 rather than its binary approximation.
+// Returns ErrInvalidAmount for NaN and infinities and ErrPrecisionLoss if the factor
+// doesn't fit in int64 terms.
+func FactorFromFloat(f float64) (Factor, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Factor{}, ErrInvalidAmount
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if !ok {
+		return Factor{}, ErrInvalidAmount
+	}
+	return reduceFactor(r.Num(), r.Denom())
+}
+
+// reduceFactor returns num/den in lowest terms with a positive denominator.
+func reduceFactor(num, den *big.Int) (Factor, error) {
+	r := new(big.Rat).SetFrac(num, den)
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return Factor{}, ErrPrecisionLoss
+	}
+	return Factor{num: r.Num().Int64(), den: r.Denom().Int64()}, nil
+}
+
+// Num returns the numerator of f.
+func (f Factor) Num() int64 {
+	return f.num
+}
+
+// Den returns the denominator of f, always positive for a valid factor.
+func (f Factor) Den() int64 {
+	return f.den
+}
+
+// Rat returns f as a big.Rat.
+func (f Factor) Rat() *big.Rat {
+	if f.den == 0 {
+		return new(big.Rat)
+	}
+	return big.NewRat(f.num, f.den)
+}
+
+// String returns f as "num/den", or just "num" for whole factors.
+func (f Factor) String() string {
+	if f.den == 1 {
+		return strconv.FormatInt(f.num, 10)
+	}
+	return strconv.FormatInt(f.num, 10) + "/" + strconv.FormatInt(f.den, 10)
+}
+
+// MulRate returns m scaled by factor, amount*num/den computed exactly on 128 bits
+// and rounded to the minor unit with the given scheme. Unlike Multiply, the
+// intermediate product never overflows, only a result outside int64 does.
+// Returns ErrDivisionByZero for the zero Factor and ErrOverflow or ErrUnderflow if
+// the result doesn't fit in int64.
+//
+// Example:
+//
+//	interest, _ := ParseFactor("1.0425")
+//	balance, err := principal.MulRate(interest, RoundHalfEven)
+func (m Money) MulRate(factor Factor, scheme RoundScheme) (*Money, error) {
+	units, err := mulDivRound(m.amount, factor.num, factor.den, scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &Money{amount: units, currency: m.currency}, nil
+}
@@ -0,0 +1,161 @@
+package goodmoney
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewFactor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		num, den int64
+		want     string
+		wantErr  error
+	}{
+		{num: 3, den: 4, want: "3/4"},
+		{num: 6, den: 8, want: "3/4"},
+		{num: 3, den: -4, want: "-3/4"},
+		{num: -10, den: -5, want: "2"},
+		{num: 0, den: 7, want: "0"},
+		{num: math.MinInt64, den: 1, want: "-9223372036854775808"},
+		{num: 1, den: math.MinInt64, wantErr: ErrPrecisionLoss},
+		{num: 1, den: 0, wantErr: ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		got, err := NewFactor(tt.num, tt.den)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("NewFactor(%d, %d) error = %v, want %v", tt.num, tt.den, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("NewFactor(%d, %d) = %s, want %s", tt.num, tt.den, got, tt.want)
+		}
+	}
+}
+
+func TestParseFactor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr error
+	}{
+		{input: "1.0825", want: "433/400"},
+		{input: "-0.5", want: "-1/2"},
+		{input: "2", want: "2"},
+		{input: "1.50", want: "3/2"},
+		{input: "3/4", want: "3/4"},
+		{input: "-6/8", want: "-3/4"},
+		{input: "0.000000000000000001", want: "1/1000000000000000000"},
+		{input: "0.0000000000000000001", wantErr: ErrPrecisionLoss},
+		{input: "92233720368547758.08", wantErr: ErrPrecisionLoss},
+		{input: "1/0", wantErr: ErrDivisionByZero},
+		{input: "1e5", wantErr: ErrInvalidAmount},
+		{input: "abc", wantErr: ErrInvalidAmount},
+		{input: "1/x", wantErr: ErrInvalidAmount},
+		{input: "", wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFactor(tt.input)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParseFactor(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("ParseFactor(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestFactorFromFloat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   float64
+		want    string
+		wantErr error
+	}{
+		{input: 1.05, want: "21/20"},
+		{input: 0.1, want: "1/10"},
+		{input: -2.5, want: "-5/2"},
+		{input: 1e10, want: "10000000000"},
+		{input: 1e-20, wantErr: ErrPrecisionLoss},
+		{input: 1e30, wantErr: ErrPrecisionLoss},
+		{input: math.NaN(), wantErr: ErrInvalidAmount},
+		{input: math.Inf(-1), wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		got, err := FactorFromFloat(tt.input)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("FactorFromFloat(%v) error = %v, want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("FactorFromFloat(%v) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestFactorAccessors(t *testing.T) {
+	t.Parallel()
+
+	f, _ := NewFactor(-3, 4)
+	if f.Num() != -3 || f.Den() != 4 || f.Rat().RatString() != "-3/4" {
+		t.Errorf("Factor = %d/%d (%s), want -3/4", f.Num(), f.Den(), f.Rat().RatString())
+	}
+	if (Factor{}).Rat().Sign() != 0 {
+		t.Errorf("zero Factor Rat() = %s, want 0", (Factor{}).Rat())
+	}
+}
+
+func TestMulRate(t *testing.T) {
+	t.Parallel()
+
+	factor := func(s string) Factor {
+		f, err := ParseFactor(s)
+		if err != nil {
+			t.Fatalf("ParseFactor(%q) unexpected error: %v", s, err)
+		}
+		return f
+	}
+
+	tests := []struct {
+		name    string
+		amount  int64
+		factor  Factor
+		scheme  RoundScheme
+		want    int64
+		wantErr error
+	}{
+		{name: "interest", amount: 100000, factor: factor("1.0425"), scheme: RoundHalfEven, want: 104250},
+		{name: "exchange rate", amount: 10000, factor: factor("57.3122"), scheme: RoundHalfEven, want: 573122},
+		{name: "rounded ratio", amount: 10000, factor: factor("1/3"), scheme: RoundHalfUp, want: 3333},
+		{name: "rounded up ratio", amount: 10000, factor: factor("2/3"), scheme: RoundTowardZero, want: 6666},
+		{name: "negative tie half up", amount: -5, factor: factor("0.5"), scheme: RoundHalfUp, want: -2},
+		{name: "tie half even", amount: 5, factor: factor("0.5"), scheme: RoundHalfEven, want: 2},
+		{name: "product beyond int64", amount: math.MaxInt64, factor: factor("999999/1000000"), scheme: RoundFloor, want: 9223362813482738952},
+		{name: "overflow", amount: math.MaxInt64, factor: factor("1.000001"), scheme: RoundHalfUp, wantErr: ErrOverflow},
+		{name: "underflow", amount: math.MinInt64, factor: factor("2"), scheme: RoundHalfUp, wantErr: ErrUnderflow},
+		{name: "zero factor", amount: 100, factor: Factor{}, scheme: RoundHalfUp, wantErr: ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mustMinorUnits(tt.amount, USD).MulRate(tt.factor, tt.scheme)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MulRate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (got.MinorUnits() != tt.want || got.Currency() != USD) {
+				t.Errorf("MulRate(%s, %v) = %d %s, want %d USD", tt.factor, tt.scheme, got.MinorUnits(), got.Currency(), tt.want)
+			}
+		})
+	}
+}
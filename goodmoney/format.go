@@ -3,7 +3,9 @@ package goodmoney
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
+	"unicode"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
@@ -34,7 +36,8 @@ const (
 type FormatOptions struct {
 	Locale        language.Tag
 	Mode          FormatMode
-	CustomPattern string // Reserved for future custom format string support
+	Precision     FormatPrecision // Decimals shown, the minor unit by default
+	CustomPattern string          // Reserved for future custom format string support
 }
 
 // formatNumber formats a number using locale-aware formatting
@@ -135,3 +138,104 @@ func formatMinimal(amount float64, symbol string, position bool, minorUnit int,
 	formattedNumber := fmt.Sprintf(formatStr, amount)
 	return formatWithSymbol(formattedNumber, symbol, position, isNegative)
 }
+
+// formatDecimalString formats a plain decimal string such as "-1234567.50" with the
+// separators, digits and minus sign of locale, keeping every digit. It reads them
+// from the locale's rendering of a sample number, so arbitrary-precision amounts
+// are formatted like formatNumber formats float64 ones.
+func formatDecimalString(locale language.Tag, decimal string) string {
+	sample := []rune(message.NewPrinter(locale).Sprintf("%v", number.Decimal(-1234567890.5, number.Scale(1))))
+
+	// split the sample into its sign, digit runs and the separators between them
+	var localDigits []rune
+	var runs []int
+	var separators []string
+	first, last := -1, -1
+	for i, r := range sample {
+		if !unicode.IsDigit(r) {
+			continue
+		}
+		if first < 0 {
+			first = i
+		} else if last != i-1 {
+			runs = append(runs, 0)
+			separators = append(separators, string(sample[last+1:i]))
+		}
+		if len(runs) == 0 {
+			runs = append(runs, 0)
+		}
+		runs[len(runs)-1]++
+		localDigits = append(localDigits, r)
+		last = i
+	}
+	if len(localDigits) != 11 || len(separators) == 0 {
+		return decimal
+	}
+
+	// sample digits are 1234567890, the last one is the fraction
+	digitOf := func(d byte) rune {
+		return localDigits[(int(d-'0')+9)%10]
+	}
+	decimalSeparator := separators[len(separators)-1]
+	primary, secondary, groupSeparator := runs[len(runs)-2], 0, ""
+	if len(runs) > 2 {
+		secondary, groupSeparator = runs[len(runs)-3], separators[len(separators)-2]
+	} else {
+		primary = 0
+	}
+
+	negative := strings.HasPrefix(decimal, "-")
+	integer, fraction, hasFraction := strings.Cut(strings.TrimPrefix(decimal, "-"), ".")
+
+	var sb strings.Builder
+	if negative {
+		sb.WriteString(string(sample[:first]))
+	}
+	for i := 0; i < len(integer); i++ {
+		if remaining := len(integer) - i; primary > 0 && i > 0 && remaining >= primary &&
+			(remaining == primary || (remaining-primary)%secondary == 0) {
+			sb.WriteString(groupSeparator)
+		}
+		sb.WriteRune(digitOf(integer[i]))
+	}
+	if hasFraction {
+		sb.WriteString(decimalSeparator)
+		for i := 0; i < len(fraction); i++ {
+			sb.WriteRune(digitOf(fraction[i]))
+		}
+	}
+	if negative {
+		sb.WriteString(string(sample[last+1:]))
+	}
+	return sb.String()
+}
+
+// formatBigCompact formats an arbitrary-precision amount of 10^-scale units in
+// compact notation like formatCompact, rounding exactly to one decimal.
+func formatBigCompact(units *big.Int, scale int, symbol string, position bool) string {
+	amount := new(big.Rat).SetFrac(units, bigPow10(scale))
+	magnitude := new(big.Rat).Abs(amount)
+
+	var exponent int
+	var suffix string
+	switch {
+	case magnitude.Cmp(new(big.Rat).SetInt64(1_000_000_000)) >= 0:
+		exponent, suffix = 9, "B"
+	case magnitude.Cmp(new(big.Rat).SetInt64(1_000_000)) >= 0:
+		exponent, suffix = 6, "M"
+	case magnitude.Cmp(new(big.Rat).SetInt64(1_000)) >= 0:
+		exponent, suffix = 3, "K"
+	default:
+		formattedNumber := formatDecimalString(language.English, string(appendBigDecimal(nil, units, scale)))
+		return formatWithSymbol(formattedNumber, symbol, position, units.Sign() < 0)
+	}
+
+	// tenths of the compact amount
+	amount.Quo(amount, new(big.Rat).SetInt(bigPow10(exponent-1)))
+	compactStr := string(appendBigDecimal(nil, roundRat(amount, RoundHalfEven), 1)) + suffix
+
+	if position {
+		return symbol + compactStr
+	}
+	return compactStr + " " + symbol
+}
@@ -0,0 +1,206 @@
+package goodmoney
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RateFallback selects what a HistoricalRateStore answers when no rate was
+// recorded on the requested date.
+type RateFallback int
+
+const (
+	// FallbackFail only accepts a rate recorded on the requested date
+	FallbackFail RateFallback = iota
+	// FallbackPrevious uses the latest rate recorded before the requested date,
+	// which is the previous business day for daily reference rates
+	FallbackPrevious
+	// FallbackNearest uses the rate recorded closest to the requested date,
+	// the earlier one on ties
+	FallbackNearest
+)
+
+// datedRate is one recorded rate of a HistoricalRateStore.
+type datedRate struct {
+	date   time.Time
+	value  *big.Rat
+	source string
+}
+
+// HistoricalRateStore is an in-memory, time-indexed RateProvider answering
+// "rate for EUR/USD as of 2025-03-31". Rates are recorded per calendar day;
+// the requested time is reduced to its date in its own location. A zero time asks
+// for the latest recorded rate. Missing pairs are answered with the inverse of the
+// opposite pair; a rate recorded on the requested date in either orientation is
+// preferred over any fallback. It is safe for concurrent use.
+type HistoricalRateStore struct {
+	mu       sync.RWMutex
+	rates    map[string][]datedRate // sorted by date
+	fallback RateFallback
+	maxGap   time.Duration
+}
+
+// NewHistoricalRateStore returns an empty HistoricalRateStore using fallback when
+// the requested date has no rate. A positive maxGap rejects fallback rates recorded
+// further than maxGap from the requested date, 0 means no limit.
+//
+// Example:
+//
+//	// previous business day, but never more than a week old
+//	store := NewHistoricalRateStore(FallbackPrevious, 7*24*time.Hour)
+//	_ = store.Add(EUR, USD, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), big.NewRat(10823, 10000))
+//	converter := NewConverter(store, RoundHalfEven)
+//	usd, err := converter.ConvertAt(ctx, invoice, USD, invoiceDate)
+func NewHistoricalRateStore(fallback RateFallback, maxGap time.Duration) *HistoricalRateStore {
+	return &HistoricalRateStore{
+		rates:    make(map[string][]datedRate),
+		fallback: fallback,
+		maxGap:   maxGap,
+	}
+}
+
+// Add records the rate of the base/quote pair on the date of the given time,
+// replacing any rate already recorded for that pair and date.
+// Returns ErrCurrencyCodeDoesNotExist for unknown currencies and ErrInvalidRate
+// if rate isn't positive.
+func (s *HistoricalRateStore) Add(base, quote string, date time.Time, rate *big.Rat) error {
+	return s.AddRates(Rate{Base: base, Quote: quote, Value: rate, Time: date})
+}
+
+// AddRates bulk loads rates, recording each on the date of its Time.
+// Rates are validated first, nothing is recorded if any of them is invalid.
+func (s *HistoricalRateStore) AddRates(rates ...Rate) error {
+	for _, r := range rates {
+		if !ValidateCurrency(r.Base) || !ValidateCurrency(r.Quote) {
+			return fmt.Errorf("%w: %s", ErrCurrencyCodeDoesNotExist, pairKey(r.Base, r.Quote))
+		}
+		if r.Value == nil || r.Value.Sign() <= 0 {
+			return fmt.Errorf("%w: %s", ErrInvalidRate, pairKey(r.Base, r.Quote))
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range rates {
+		source := r.Source
+		if source == "" {
+			source = "historical"
+		}
+		s.insert(pairKey(r.Base, r.Quote), datedRate{
+			date:   dateOf(r.Time),
+			value:  new(big.Rat).Set(r.Value),
+			source: source,
+		})
+	}
+	return nil
+}
+
+// insert adds rate to the series of key, keeping it sorted by date.
+func (s *HistoricalRateStore) insert(key string, rate datedRate) {
+	series := s.rates[key]
+
+	// fast path for loads in chronological order
+	if n := len(series); n == 0 || series[n-1].date.Before(rate.date) {
+		s.rates[key] = append(series, rate)
+		return
+	}
+
+	i := sort.Search(len(series), func(i int) bool { return !series[i].date.Before(rate.date) })
+	if i < len(series) && series[i].date.Equal(rate.date) {
+		series[i] = rate
+		return
+	}
+	series = append(series, datedRate{})
+	copy(series[i+1:], series[i:])
+	series[i] = rate
+	s.rates[key] = series
+}
+
+// Rate implements RateProvider.
+func (s *HistoricalRateStore) Rate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	if base == quote {
+		return Rate{Base: base, Quote: quote, Value: big.NewRat(1, 1), Time: at, Source: "historical"}, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	direct, inverse := pairKey(base, quote), pairKey(quote, base)
+	// a rate recorded on the date in either orientation beats any fallback
+	for _, exact := range []bool{true, false} {
+		if r, ok := s.find(direct, at, exact); ok {
+			return Rate{Base: base, Quote: quote, Value: r.value, Time: r.date, Source: r.source}, nil
+		}
+		if r, ok := s.find(inverse, at, exact); ok {
+			return Rate{Base: base, Quote: quote, Value: new(big.Rat).Inv(r.value), Time: r.date, Source: r.source, Inverted: true}, nil
+		}
+	}
+
+	if at.IsZero() {
+		return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, pairKey(base, quote))
+	}
+	return Rate{}, fmt.Errorf("%w: %s as of %s", ErrRateNotFound, pairKey(base, quote), dateOf(at).Format(time.DateOnly))
+}
+
+// find returns the rate of the series of key to use at the given time,
+// only a rate recorded on its date if exact is set.
+func (s *HistoricalRateStore) find(key string, at time.Time, exact bool) (datedRate, bool) {
+	series := s.rates[key]
+	if len(series) == 0 {
+		return datedRate{}, false
+	}
+	if at.IsZero() {
+		return series[len(series)-1], true
+	}
+
+	date := dateOf(at)
+	// index of the first rate after date
+	i := sort.Search(len(series), func(i int) bool { return series[i].date.After(date) })
+	if i > 0 && series[i-1].date.Equal(date) {
+		return series[i-1], true
+	}
+	if exact {
+		return datedRate{}, false
+	}
+
+	var candidate *datedRate
+	switch s.fallback {
+	case FallbackPrevious:
+		if i > 0 {
+			candidate = &series[i-1]
+		}
+	case FallbackNearest:
+		switch {
+		case i == 0:
+			candidate = &series[0]
+		case i == len(series):
+			candidate = &series[i-1]
+		case series[i].date.Sub(date) < date.Sub(series[i-1].date):
+			candidate = &series[i]
+		default:
+			candidate = &series[i-1]
+		}
+	}
+	if candidate == nil {
+		return datedRate{}, false
+	}
+
+	gap := date.Sub(candidate.date)
+	if gap < 0 {
+		gap = -gap
+	}
+	if s.maxGap > 0 && gap > s.maxGap {
+		return datedRate{}, false
+	}
+	return *candidate, true
+}
+
+// dateOf returns midnight UTC of the calendar date of t in its own location.
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
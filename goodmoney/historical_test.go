@@ -0,0 +1,205 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestHistoricalRateStore(t *testing.T) {
+	t.Parallel()
+
+	date := func(day int) time.Time {
+		return time.Date(2025, 3, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	// Friday 28th and Monday 31st, nothing over the weekend; loaded out of order
+	load := func(fallback RateFallback, maxGap time.Duration) *HistoricalRateStore {
+		s := NewHistoricalRateStore(fallback, maxGap)
+		err := s.AddRates(
+			Rate{Base: EUR, Quote: USD, Value: big.NewRat(10823, 10000), Time: date(31), Source: "ECB"},
+			Rate{Base: EUR, Quote: USD, Value: big.NewRat(10790, 10000), Time: date(28), Source: "ECB"},
+			Rate{Base: EUR, Quote: USD, Value: big.NewRat(10801, 10000), Time: date(20), Source: "ECB"},
+		)
+		if err != nil {
+			t.Fatalf("AddRates() unexpected error: %v", err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name      string
+		fallback  RateFallback
+		maxGap    time.Duration
+		base      string
+		quote     string
+		at        time.Time
+		wantValue *big.Rat
+		wantDate  time.Time
+		wantInv   bool
+		wantErr   error
+	}{
+		{name: "exact date", fallback: FallbackFail, base: EUR, quote: USD, at: date(28), wantValue: big.NewRat(10790, 10000), wantDate: date(28)},
+		{name: "time of day is ignored", fallback: FallbackFail, base: EUR, quote: USD, at: date(28).Add(17 * time.Hour), wantValue: big.NewRat(10790, 10000), wantDate: date(28)},
+		{name: "date in its own location", fallback: FallbackFail, base: EUR, quote: USD, at: time.Date(2025, 3, 31, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*3600)), wantValue: big.NewRat(10823, 10000), wantDate: date(31)},
+		{name: "latest", fallback: FallbackFail, base: EUR, quote: USD, wantValue: big.NewRat(10823, 10000), wantDate: date(31)},
+		{name: "inverse", fallback: FallbackFail, base: USD, quote: EUR, at: date(31), wantValue: big.NewRat(10000, 10823), wantDate: date(31), wantInv: true},
+		{name: "fail on weekend", fallback: FallbackFail, base: EUR, quote: USD, at: date(29), wantErr: ErrRateNotFound},
+		{name: "previous business day", fallback: FallbackPrevious, base: EUR, quote: USD, at: date(30), wantValue: big.NewRat(10790, 10000), wantDate: date(28)},
+		{name: "previous after the last rate", fallback: FallbackPrevious, base: EUR, quote: USD, at: date(31).AddDate(0, 0, 3), wantValue: big.NewRat(10823, 10000), wantDate: date(31)},
+		{name: "previous before the first rate", fallback: FallbackPrevious, base: EUR, quote: USD, at: date(1), wantErr: ErrRateNotFound},
+		{name: "previous within max gap", fallback: FallbackPrevious, maxGap: 72 * time.Hour, base: EUR, quote: USD, at: date(30), wantValue: big.NewRat(10790, 10000), wantDate: date(28)},
+		{name: "previous beyond max gap", fallback: FallbackPrevious, maxGap: 72 * time.Hour, base: EUR, quote: USD, at: date(27), wantErr: ErrRateNotFound},
+		{name: "nearest later", fallback: FallbackNearest, base: EUR, quote: USD, at: date(30), wantValue: big.NewRat(10823, 10000), wantDate: date(31)},
+		{name: "nearest earlier", fallback: FallbackNearest, base: EUR, quote: USD, at: date(22), wantValue: big.NewRat(10801, 10000), wantDate: date(20)},
+		{name: "nearest tie goes earlier", fallback: FallbackNearest, base: EUR, quote: USD, at: date(24), wantValue: big.NewRat(10801, 10000), wantDate: date(20)},
+		{name: "nearest before the first rate", fallback: FallbackNearest, base: EUR, quote: USD, at: date(1), wantValue: big.NewRat(10801, 10000), wantDate: date(20)},
+		{name: "nearest beyond max gap", fallback: FallbackNearest, maxGap: 24 * time.Hour, base: EUR, quote: USD, at: date(1), wantErr: ErrRateNotFound},
+		{name: "unknown pair", fallback: FallbackNearest, base: EUR, quote: ETB, at: date(28), wantErr: ErrRateNotFound},
+		{name: "unknown pair latest", fallback: FallbackNearest, base: EUR, quote: ETB, wantErr: ErrRateNotFound},
+		{name: "same currency", fallback: FallbackFail, base: EUR, quote: EUR, at: date(29), wantValue: big.NewRat(1, 1), wantDate: date(29)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := load(tt.fallback, tt.maxGap).Rate(context.Background(), tt.base, tt.quote, tt.at)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Value.Cmp(tt.wantValue) != 0 {
+				t.Errorf("Rate() value = %s, want %s", got.Value, tt.wantValue)
+			}
+			if !got.Time.Equal(tt.wantDate) {
+				t.Errorf("Rate() time = %v, want %v", got.Time, tt.wantDate)
+			}
+			if got.Inverted != tt.wantInv {
+				t.Errorf("Rate() inverted = %v, want %v", got.Inverted, tt.wantInv)
+			}
+		})
+	}
+}
+
+func TestHistoricalRateStoreAdd(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	day := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	s := NewHistoricalRateStore(FallbackFail, 0)
+
+	if err := s.Add(EUR, USD, day, big.NewRat(108, 100)); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	// replaces the rate of the same date
+	if err := s.Add(EUR, USD, day.Add(time.Hour), big.NewRat(109, 100)); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	got, err := s.Rate(ctx, EUR, USD, day)
+	if err != nil || got.Value.Cmp(big.NewRat(109, 100)) != 0 || got.Source != "historical" {
+		t.Errorf("Rate() = %+v, %v, want 1.09 from historical", got, err)
+	}
+
+	if err := s.Add(EUR, "INVALID", day, big.NewRat(1, 1)); !errors.Is(err, ErrCurrencyCodeDoesNotExist) {
+		t.Errorf("Add() error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+	if err := s.Add(EUR, USD, day, big.NewRat(0, 1)); !errors.Is(err, ErrInvalidRate) {
+		t.Errorf("Add() error = %v, want %v", err, ErrInvalidRate)
+	}
+
+	// a bulk load with one invalid rate records nothing
+	err = s.AddRates(
+		Rate{Base: EUR, Quote: GBP, Value: big.NewRat(83, 100), Time: day},
+		Rate{Base: EUR, Quote: GBP, Time: day},
+	)
+	if !errors.Is(err, ErrInvalidRate) {
+		t.Errorf("AddRates() error = %v, want %v", err, ErrInvalidRate)
+	}
+	if _, err := s.Rate(ctx, EUR, GBP, day); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Rate() error = %v, want %v", err, ErrRateNotFound)
+	}
+}
+
+func TestHistoricalRateStoreExactDateInEitherOrientation(t *testing.T) {
+	t.Parallel()
+
+	date := func(day int) time.Time {
+		return time.Date(2025, 3, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	// USD/EUR on the 31st, EUR/USD only on the 28th
+	s := NewHistoricalRateStore(FallbackPrevious, 0)
+	err := s.AddRates(
+		Rate{Base: USD, Quote: EUR, Value: big.NewRat(10000, 10823), Time: date(31)},
+		Rate{Base: EUR, Quote: USD, Value: big.NewRat(10790, 10000), Time: date(28)},
+	)
+	if err != nil {
+		t.Fatalf("AddRates() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		base      string
+		quote     string
+		at        time.Time
+		wantValue *big.Rat
+		wantDate  time.Time
+		wantInv   bool
+	}{
+		{name: "inverse on the date beats an earlier direct rate", base: EUR, quote: USD, at: date(31), wantValue: big.NewRat(10823, 10000), wantDate: date(31), wantInv: true},
+		{name: "direct on the date", base: USD, quote: EUR, at: date(31), wantValue: big.NewRat(10000, 10823), wantDate: date(31)},
+		{name: "fallback to the earlier direct rate", base: EUR, quote: USD, at: date(30), wantValue: big.NewRat(10790, 10000), wantDate: date(28)},
+		{name: "fallback to the earlier inverse rate", base: USD, quote: EUR, at: date(30), wantValue: big.NewRat(10000, 10790), wantDate: date(28), wantInv: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := s.Rate(context.Background(), tt.base, tt.quote, tt.at)
+			if err != nil {
+				t.Fatalf("Rate() unexpected error: %v", err)
+			}
+			if got.Value.Cmp(tt.wantValue) != 0 {
+				t.Errorf("Rate() value = %s, want %s", got.Value, tt.wantValue)
+			}
+			if !got.Time.Equal(tt.wantDate) {
+				t.Errorf("Rate() time = %v, want %v", got.Time, tt.wantDate)
+			}
+			if got.Inverted != tt.wantInv {
+				t.Errorf("Rate() inverted = %v, want %v", got.Inverted, tt.wantInv)
+			}
+		})
+	}
+}
+
+func TestConvertAtHistoricalRate(t *testing.T) {
+	t.Parallel()
+
+	s := NewHistoricalRateStore(FallbackPrevious, 0)
+	_ = s.Add(EUR, USD, time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), big.NewRat(108, 100))
+	_ = s.Add(EUR, USD, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), big.NewRat(110, 100))
+
+	invoice, _ := NewFromString("1000.00", EUR)
+	converter := NewConverter(s, RoundHalfEven)
+
+	got, err := converter.ConvertAt(context.Background(), invoice, USD, time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ConvertAt() unexpected error: %v", err)
+	}
+	if got.String() != "1080.00 USD" {
+		t.Errorf("ConvertAt() = %s, want 1080.00 USD", got)
+	}
+
+	latest, err := converter.Convert(context.Background(), invoice, USD)
+	if err != nil || latest.String() != "1100.00 USD" {
+		t.Errorf("Convert() = %s, %v, want 1100.00 USD", latest, err)
+	}
+}
@@ -0,0 +1,9 @@
+// Package iso4217 provides one zero-size type per ISO 4217 currency of goodmoney.CurrencyMap,
+// used as the type parameter of goodmoney.Amount to fix a currency at compile time.
+//
+// Example:
+//
+//	price := goodmoney.AmountFromMinorUnits[iso4217.USD](1999)
+package iso4217
+
+//go:generate go run gen.go
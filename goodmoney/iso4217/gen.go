@@ -0,0 +1,42 @@
+//go:build ignore
+
+// gen writes iso4217.go, one tag type per currency of goodmoney.CurrencyMap.
+// Run it with go generate after editing CurrencyMap.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+
+	"github.com/nucleus-proj/goodmoney/goodmoney"
+)
+
+func main() {
+	codes := make([]string, 0, len(goodmoney.CurrencyMap))
+	for code := range goodmoney.CurrencyMap {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go from goodmoney.CurrencyMap; DO NOT EDIT.\n\n")
+	b.WriteString("package iso4217\n")
+	for _, code := range codes {
+		fmt.Fprintf(&b, "\n// %s is the compile-time tag of the %s currency.\n", code, code)
+		fmt.Fprintf(&b, "type %s struct{}\n\n", code)
+		fmt.Fprintf(&b, "// CurrencyCode returns %q.\n", code)
+		fmt.Fprintf(&b, "func (%s) CurrencyCode() string { return %q }\n", code, code)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("formatting iso4217.go: %v", err)
+	}
+	if err := os.WriteFile("iso4217.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,1077 @@
+// Code generated by gen.go from goodmoney.CurrencyMap; DO NOT EDIT.
+
+package iso4217
+
+// AED is the compile-time tag of the AED currency.
+type AED struct{}
+
+// CurrencyCode returns "AED".
+func (AED) CurrencyCode() string { return "AED" }
+
+// AFN is the compile-time tag of the AFN currency.
+type AFN struct{}
+
+// CurrencyCode returns "AFN".
+func (AFN) CurrencyCode() string { return "AFN" }
+
+// ALL is the compile-time tag of the ALL currency.
+type ALL struct{}
+
+// CurrencyCode returns "ALL".
+func (ALL) CurrencyCode() string { return "ALL" }
+
+// AMD is the compile-time tag of the AMD currency.
+type AMD struct{}
+
+// CurrencyCode returns "AMD".
+func (AMD) CurrencyCode() string { return "AMD" }
+
+// AOA is the compile-time tag of the AOA currency.
+type AOA struct{}
+
+// CurrencyCode returns "AOA".
+func (AOA) CurrencyCode() string { return "AOA" }
+
+// ARS is the compile-time tag of the ARS currency.
+type ARS struct{}
+
+// CurrencyCode returns "ARS".
+func (ARS) CurrencyCode() string { return "ARS" }
+
+// AUD is the compile-time tag of the AUD currency.
+type AUD struct{}
+
+// CurrencyCode returns "AUD".
+func (AUD) CurrencyCode() string { return "AUD" }
+
+// AWG is the compile-time tag of the AWG currency.
+type AWG struct{}
+
+// CurrencyCode returns "AWG".
+func (AWG) CurrencyCode() string { return "AWG" }
+
+// AZN is the compile-time tag of the AZN currency.
+type AZN struct{}
+
+// CurrencyCode returns "AZN".
+func (AZN) CurrencyCode() string { return "AZN" }
+
+// BAM is the compile-time tag of the BAM currency.
+type BAM struct{}
+
+// CurrencyCode returns "BAM".
+func (BAM) CurrencyCode() string { return "BAM" }
+
+// BBD is the compile-time tag of the BBD currency.
+type BBD struct{}
+
+// CurrencyCode returns "BBD".
+func (BBD) CurrencyCode() string { return "BBD" }
+
+// BDT is the compile-time tag of the BDT currency.
+type BDT struct{}
+
+// CurrencyCode returns "BDT".
+func (BDT) CurrencyCode() string { return "BDT" }
+
+// BGN is the compile-time tag of the BGN currency.
+type BGN struct{}
+
+// CurrencyCode returns "BGN".
+func (BGN) CurrencyCode() string { return "BGN" }
+
+// BHD is the compile-time tag of the BHD currency.
+type BHD struct{}
+
+// CurrencyCode returns "BHD".
+func (BHD) CurrencyCode() string { return "BHD" }
+
+// BIF is the compile-time tag of the BIF currency.
+type BIF struct{}
+
+// CurrencyCode returns "BIF".
+func (BIF) CurrencyCode() string { return "BIF" }
+
+// BMD is the compile-time tag of the BMD currency.
+type BMD struct{}
+
+// CurrencyCode returns "BMD".
+func (BMD) CurrencyCode() string { return "BMD" }
+
+// BND is the compile-time tag of the BND currency.
+type BND struct{}
+
+// CurrencyCode returns "BND".
+func (BND) CurrencyCode() string { return "BND" }
+
+// BOB is the compile-time tag of the BOB currency.
+type BOB struct{}
+
+// CurrencyCode returns "BOB".
+func (BOB) CurrencyCode() string { return "BOB" }
+
+// BOV is the compile-time tag of the BOV currency.
+type BOV struct{}
+
+// CurrencyCode returns "BOV".
+func (BOV) CurrencyCode() string { return "BOV" }
+
+// BRL is the compile-time tag of the BRL currency.
+type BRL struct{}
+
+// CurrencyCode returns "BRL".
+func (BRL) CurrencyCode() string { return "BRL" }
+
+// BSD is the compile-time tag of the BSD currency.
+type BSD struct{}
+
+// CurrencyCode returns "BSD".
+func (BSD) CurrencyCode() string { return "BSD" }
+
+// BTN is the compile-time tag of the BTN currency.
+type BTN struct{}
+
+// CurrencyCode returns "BTN".
+func (BTN) CurrencyCode() string { return "BTN" }
+
+// BWP is the compile-time tag of the BWP currency.
+type BWP struct{}
+
+// CurrencyCode returns "BWP".
+func (BWP) CurrencyCode() string { return "BWP" }
+
+// BYN is the compile-time tag of the BYN currency.
+type BYN struct{}
+
+// CurrencyCode returns "BYN".
+func (BYN) CurrencyCode() string { return "BYN" }
+
+// BZD is the compile-time tag of the BZD currency.
+type BZD struct{}
+
+// CurrencyCode returns "BZD".
+func (BZD) CurrencyCode() string { return "BZD" }
+
+// CAD is the compile-time tag of the CAD currency.
+type CAD struct{}
+
+// CurrencyCode returns "CAD".
+func (CAD) CurrencyCode() string { return "CAD" }
+
+// CDF is the compile-time tag of the CDF currency.
+type CDF struct{}
+
+// CurrencyCode returns "CDF".
+func (CDF) CurrencyCode() string { return "CDF" }
+
+// CHE is the compile-time tag of the CHE currency.
+type CHE struct{}
+
+// CurrencyCode returns "CHE".
+func (CHE) CurrencyCode() string { return "CHE" }
+
+// CHF is the compile-time tag of the CHF currency.
+type CHF struct{}
+
+// CurrencyCode returns "CHF".
+func (CHF) CurrencyCode() string { return "CHF" }
+
+// CHW is the compile-time tag of the CHW currency.
+type CHW struct{}
+
+// CurrencyCode returns "CHW".
+func (CHW) CurrencyCode() string { return "CHW" }
+
+// CLF is the compile-time tag of the CLF currency.
+type CLF struct{}
+
+// CurrencyCode returns "CLF".
+func (CLF) CurrencyCode() string { return "CLF" }
+
+// CLP is the compile-time tag of the CLP currency.
+type CLP struct{}
+
+// CurrencyCode returns "CLP".
+func (CLP) CurrencyCode() string { return "CLP" }
+
+// CNY is the compile-time tag of the CNY currency.
+type CNY struct{}
+
+// CurrencyCode returns "CNY".
+func (CNY) CurrencyCode() string { return "CNY" }
+
+// COP is the compile-time tag of the COP currency.
+type COP struct{}
+
+// CurrencyCode returns "COP".
+func (COP) CurrencyCode() string { return "COP" }
+
+// COU is the compile-time tag of the COU currency.
+type COU struct{}
+
+// CurrencyCode returns "COU".
+func (COU) CurrencyCode() string { return "COU" }
+
+// CRC is the compile-time tag of the CRC currency.
+type CRC struct{}
+
+// CurrencyCode returns "CRC".
+func (CRC) CurrencyCode() string { return "CRC" }
+
+// CUP is the compile-time tag of the CUP currency.
+type CUP struct{}
+
+// CurrencyCode returns "CUP".
+func (CUP) CurrencyCode() string { return "CUP" }
+
+// CVE is the compile-time tag of the CVE currency.
+type CVE struct{}
+
+// CurrencyCode returns "CVE".
+func (CVE) CurrencyCode() string { return "CVE" }
+
+// CZK is the compile-time tag of the CZK currency.
+type CZK struct{}
+
+// CurrencyCode returns "CZK".
+func (CZK) CurrencyCode() string { return "CZK" }
+
+// DJF is the compile-time tag of the DJF currency.
+type DJF struct{}
+
+// CurrencyCode returns "DJF".
+func (DJF) CurrencyCode() string { return "DJF" }
+
+// DKK is the compile-time tag of the DKK currency.
+type DKK struct{}
+
+// CurrencyCode returns "DKK".
+func (DKK) CurrencyCode() string { return "DKK" }
+
+// DOP is the compile-time tag of the DOP currency.
+type DOP struct{}
+
+// CurrencyCode returns "DOP".
+func (DOP) CurrencyCode() string { return "DOP" }
+
+// DZD is the compile-time tag of the DZD currency.
+type DZD struct{}
+
+// CurrencyCode returns "DZD".
+func (DZD) CurrencyCode() string { return "DZD" }
+
+// EGP is the compile-time tag of the EGP currency.
+type EGP struct{}
+
+// CurrencyCode returns "EGP".
+func (EGP) CurrencyCode() string { return "EGP" }
+
+// ERN is the compile-time tag of the ERN currency.
+type ERN struct{}
+
+// CurrencyCode returns "ERN".
+func (ERN) CurrencyCode() string { return "ERN" }
+
+// ETB is the compile-time tag of the ETB currency.
+type ETB struct{}
+
+// CurrencyCode returns "ETB".
+func (ETB) CurrencyCode() string { return "ETB" }
+
+// EUR is the compile-time tag of the EUR currency.
+type EUR struct{}
+
+// CurrencyCode returns "EUR".
+func (EUR) CurrencyCode() string { return "EUR" }
+
+// FJD is the compile-time tag of the FJD currency.
+type FJD struct{}
+
+// CurrencyCode returns "FJD".
+func (FJD) CurrencyCode() string { return "FJD" }
+
+// FKP is the compile-time tag of the FKP currency.
+type FKP struct{}
+
+// CurrencyCode returns "FKP".
+func (FKP) CurrencyCode() string { return "FKP" }
+
+// GBP is the compile-time tag of the GBP currency.
+type GBP struct{}
+
+// CurrencyCode returns "GBP".
+func (GBP) CurrencyCode() string { return "GBP" }
+
+// GEL is the compile-time tag of the GEL currency.
+type GEL struct{}
+
+// CurrencyCode returns "GEL".
+func (GEL) CurrencyCode() string { return "GEL" }
+
+// GHS is the compile-time tag of the GHS currency.
+type GHS struct{}
+
+// CurrencyCode returns "GHS".
+func (GHS) CurrencyCode() string { return "GHS" }
+
+// GIP is the compile-time tag of the GIP currency.
+type GIP struct{}
+
+// CurrencyCode returns "GIP".
+func (GIP) CurrencyCode() string { return "GIP" }
+
+// GMD is the compile-time tag of the GMD currency.
+type GMD struct{}
+
+// CurrencyCode returns "GMD".
+func (GMD) CurrencyCode() string { return "GMD" }
+
+// GNF is the compile-time tag of the GNF currency.
+type GNF struct{}
+
+// CurrencyCode returns "GNF".
+func (GNF) CurrencyCode() string { return "GNF" }
+
+// GTQ is the compile-time tag of the GTQ currency.
+type GTQ struct{}
+
+// CurrencyCode returns "GTQ".
+func (GTQ) CurrencyCode() string { return "GTQ" }
+
+// GYD is the compile-time tag of the GYD currency.
+type GYD struct{}
+
+// CurrencyCode returns "GYD".
+func (GYD) CurrencyCode() string { return "GYD" }
+
+// HKD is the compile-time tag of the HKD currency.
+type HKD struct{}
+
+// CurrencyCode returns "HKD".
+func (HKD) CurrencyCode() string { return "HKD" }
+
+// HNL is the compile-time tag of the HNL currency.
+type HNL struct{}
+
+// CurrencyCode returns "HNL".
+func (HNL) CurrencyCode() string { return "HNL" }
+
+// HTG is the compile-time tag of the HTG currency.
+type HTG struct{}
+
+// CurrencyCode returns "HTG".
+func (HTG) CurrencyCode() string { return "HTG" }
+
+// HUF is the compile-time tag of the HUF currency.
+type HUF struct{}
+
+// CurrencyCode returns "HUF".
+func (HUF) CurrencyCode() string { return "HUF" }
+
+// IDR is the compile-time tag of the IDR currency.
+type IDR struct{}
+
+// CurrencyCode returns "IDR".
+func (IDR) CurrencyCode() string { return "IDR" }
+
+// ILS is the compile-time tag of the ILS currency.
+type ILS struct{}
+
+// CurrencyCode returns "ILS".
+func (ILS) CurrencyCode() string { return "ILS" }
+
+// INR is the compile-time tag of the INR currency.
+type INR struct{}
+
+// CurrencyCode returns "INR".
+func (INR) CurrencyCode() string { return "INR" }
+
+// IQD is the compile-time tag of the IQD currency.
+type IQD struct{}
+
+// CurrencyCode returns "IQD".
+func (IQD) CurrencyCode() string { return "IQD" }
+
+// IRR is the compile-time tag of the IRR currency.
+type IRR struct{}
+
+// CurrencyCode returns "IRR".
+func (IRR) CurrencyCode() string { return "IRR" }
+
+// ISK is the compile-time tag of the ISK currency.
+type ISK struct{}
+
+// CurrencyCode returns "ISK".
+func (ISK) CurrencyCode() string { return "ISK" }
+
+// JMD is the compile-time tag of the JMD currency.
+type JMD struct{}
+
+// CurrencyCode returns "JMD".
+func (JMD) CurrencyCode() string { return "JMD" }
+
+// JOD is the compile-time tag of the JOD currency.
+type JOD struct{}
+
+// CurrencyCode returns "JOD".
+func (JOD) CurrencyCode() string { return "JOD" }
+
+// JPY is the compile-time tag of the JPY currency.
+type JPY struct{}
+
+// CurrencyCode returns "JPY".
+func (JPY) CurrencyCode() string { return "JPY" }
+
+// KES is the compile-time tag of the KES currency.
+type KES struct{}
+
+// CurrencyCode returns "KES".
+func (KES) CurrencyCode() string { return "KES" }
+
+// KGS is the compile-time tag of the KGS currency.
+type KGS struct{}
+
+// CurrencyCode returns "KGS".
+func (KGS) CurrencyCode() string { return "KGS" }
+
+// KHR is the compile-time tag of the KHR currency.
+type KHR struct{}
+
+// CurrencyCode returns "KHR".
+func (KHR) CurrencyCode() string { return "KHR" }
+
+// KMF is the compile-time tag of the KMF currency.
+type KMF struct{}
+
+// CurrencyCode returns "KMF".
+func (KMF) CurrencyCode() string { return "KMF" }
+
+// KPW is the compile-time tag of the KPW currency.
+type KPW struct{}
+
+// CurrencyCode returns "KPW".
+func (KPW) CurrencyCode() string { return "KPW" }
+
+// KRW is the compile-time tag of the KRW currency.
+type KRW struct{}
+
+// CurrencyCode returns "KRW".
+func (KRW) CurrencyCode() string { return "KRW" }
+
+// KWD is the compile-time tag of the KWD currency.
+type KWD struct{}
+
+// CurrencyCode returns "KWD".
+func (KWD) CurrencyCode() string { return "KWD" }
+
+// KYD is the compile-time tag of the KYD currency.
+type KYD struct{}
+
+// CurrencyCode returns "KYD".
+func (KYD) CurrencyCode() string { return "KYD" }
+
+// KZT is the compile-time tag of the KZT currency.
+type KZT struct{}
+
+// CurrencyCode returns "KZT".
+func (KZT) CurrencyCode() string { return "KZT" }
+
+// LAK is the compile-time tag of the LAK currency.
+type LAK struct{}
+
+// CurrencyCode returns "LAK".
+func (LAK) CurrencyCode() string { return "LAK" }
+
+// LBP is the compile-time tag of the LBP currency.
+type LBP struct{}
+
+// CurrencyCode returns "LBP".
+func (LBP) CurrencyCode() string { return "LBP" }
+
+// LKR is the compile-time tag of the LKR currency.
+type LKR struct{}
+
+// CurrencyCode returns "LKR".
+func (LKR) CurrencyCode() string { return "LKR" }
+
+// LRD is the compile-time tag of the LRD currency.
+type LRD struct{}
+
+// CurrencyCode returns "LRD".
+func (LRD) CurrencyCode() string { return "LRD" }
+
+// LSL is the compile-time tag of the LSL currency.
+type LSL struct{}
+
+// CurrencyCode returns "LSL".
+func (LSL) CurrencyCode() string { return "LSL" }
+
+// LYD is the compile-time tag of the LYD currency.
+type LYD struct{}
+
+// CurrencyCode returns "LYD".
+func (LYD) CurrencyCode() string { return "LYD" }
+
+// MAD is the compile-time tag of the MAD currency.
+type MAD struct{}
+
+// CurrencyCode returns "MAD".
+func (MAD) CurrencyCode() string { return "MAD" }
+
+// MDL is the compile-time tag of the MDL currency.
+type MDL struct{}
+
+// CurrencyCode returns "MDL".
+func (MDL) CurrencyCode() string { return "MDL" }
+
+// MGA is the compile-time tag of the MGA currency.
+type MGA struct{}
+
+// CurrencyCode returns "MGA".
+func (MGA) CurrencyCode() string { return "MGA" }
+
+// MKD is the compile-time tag of the MKD currency.
+type MKD struct{}
+
+// CurrencyCode returns "MKD".
+func (MKD) CurrencyCode() string { return "MKD" }
+
+// MMK is the compile-time tag of the MMK currency.
+type MMK struct{}
+
+// CurrencyCode returns "MMK".
+func (MMK) CurrencyCode() string { return "MMK" }
+
+// MNT is the compile-time tag of the MNT currency.
+type MNT struct{}
+
+// CurrencyCode returns "MNT".
+func (MNT) CurrencyCode() string { return "MNT" }
+
+// MOP is the compile-time tag of the MOP currency.
+type MOP struct{}
+
+// CurrencyCode returns "MOP".
+func (MOP) CurrencyCode() string { return "MOP" }
+
+// MRU is the compile-time tag of the MRU currency.
+type MRU struct{}
+
+// CurrencyCode returns "MRU".
+func (MRU) CurrencyCode() string { return "MRU" }
+
+// MUR is the compile-time tag of the MUR currency.
+type MUR struct{}
+
+// CurrencyCode returns "MUR".
+func (MUR) CurrencyCode() string { return "MUR" }
+
+// MVR is the compile-time tag of the MVR currency.
+type MVR struct{}
+
+// CurrencyCode returns "MVR".
+func (MVR) CurrencyCode() string { return "MVR" }
+
+// MWK is the compile-time tag of the MWK currency.
+type MWK struct{}
+
+// CurrencyCode returns "MWK".
+func (MWK) CurrencyCode() string { return "MWK" }
+
+// MXN is the compile-time tag of the MXN currency.
+type MXN struct{}
+
+// CurrencyCode returns "MXN".
+func (MXN) CurrencyCode() string { return "MXN" }
+
+// MXV is the compile-time tag of the MXV currency.
+type MXV struct{}
+
+// CurrencyCode returns "MXV".
+func (MXV) CurrencyCode() string { return "MXV" }
+
+// MYR is the compile-time tag of the MYR currency.
+type MYR struct{}
+
+// CurrencyCode returns "MYR".
+func (MYR) CurrencyCode() string { return "MYR" }
+
+// MZN is the compile-time tag of the MZN currency.
+type MZN struct{}
+
+// CurrencyCode returns "MZN".
+func (MZN) CurrencyCode() string { return "MZN" }
+
+// NAD is the compile-time tag of the NAD currency.
+type NAD struct{}
+
+// CurrencyCode returns "NAD".
+func (NAD) CurrencyCode() string { return "NAD" }
+
+// NGN is the compile-time tag of the NGN currency.
+type NGN struct{}
+
+// CurrencyCode returns "NGN".
+func (NGN) CurrencyCode() string { return "NGN" }
+
+// NIO is the compile-time tag of the NIO currency.
+type NIO struct{}
+
+// CurrencyCode returns "NIO".
+func (NIO) CurrencyCode() string { return "NIO" }
+
+// NOK is the compile-time tag of the NOK currency.
+type NOK struct{}
+
+// CurrencyCode returns "NOK".
+func (NOK) CurrencyCode() string { return "NOK" }
+
+// NPR is the compile-time tag of the NPR currency.
+type NPR struct{}
+
+// CurrencyCode returns "NPR".
+func (NPR) CurrencyCode() string { return "NPR" }
+
+// NZD is the compile-time tag of the NZD currency.
+type NZD struct{}
+
+// CurrencyCode returns "NZD".
+func (NZD) CurrencyCode() string { return "NZD" }
+
+// OMR is the compile-time tag of the OMR currency.
+type OMR struct{}
+
+// CurrencyCode returns "OMR".
+func (OMR) CurrencyCode() string { return "OMR" }
+
+// PAB is the compile-time tag of the PAB currency.
+type PAB struct{}
+
+// CurrencyCode returns "PAB".
+func (PAB) CurrencyCode() string { return "PAB" }
+
+// PEN is the compile-time tag of the PEN currency.
+type PEN struct{}
+
+// CurrencyCode returns "PEN".
+func (PEN) CurrencyCode() string { return "PEN" }
+
+// PGK is the compile-time tag of the PGK currency.
+type PGK struct{}
+
+// CurrencyCode returns "PGK".
+func (PGK) CurrencyCode() string { return "PGK" }
+
+// PHP is the compile-time tag of the PHP currency.
+type PHP struct{}
+
+// CurrencyCode returns "PHP".
+func (PHP) CurrencyCode() string { return "PHP" }
+
+// PKR is the compile-time tag of the PKR currency.
+type PKR struct{}
+
+// CurrencyCode returns "PKR".
+func (PKR) CurrencyCode() string { return "PKR" }
+
+// PLN is the compile-time tag of the PLN currency.
+type PLN struct{}
+
+// CurrencyCode returns "PLN".
+func (PLN) CurrencyCode() string { return "PLN" }
+
+// PYG is the compile-time tag of the PYG currency.
+type PYG struct{}
+
+// CurrencyCode returns "PYG".
+func (PYG) CurrencyCode() string { return "PYG" }
+
+// QAR is the compile-time tag of the QAR currency.
+type QAR struct{}
+
+// CurrencyCode returns "QAR".
+func (QAR) CurrencyCode() string { return "QAR" }
+
+// RON is the compile-time tag of the RON currency.
+type RON struct{}
+
+// CurrencyCode returns "RON".
+func (RON) CurrencyCode() string { return "RON" }
+
+// RSD is the compile-time tag of the RSD currency.
+type RSD struct{}
+
+// CurrencyCode returns "RSD".
+func (RSD) CurrencyCode() string { return "RSD" }
+
+// RUB is the compile-time tag of the RUB currency.
+type RUB struct{}
+
+// CurrencyCode returns "RUB".
+func (RUB) CurrencyCode() string { return "RUB" }
+
+// RWF is the compile-time tag of the RWF currency.
+type RWF struct{}
+
+// CurrencyCode returns "RWF".
+func (RWF) CurrencyCode() string { return "RWF" }
+
+// SAR is the compile-time tag of the SAR currency.
+type SAR struct{}
+
+// CurrencyCode returns "SAR".
+func (SAR) CurrencyCode() string { return "SAR" }
+
+// SBD is the compile-time tag of the SBD currency.
+type SBD struct{}
+
+// CurrencyCode returns "SBD".
+func (SBD) CurrencyCode() string { return "SBD" }
+
+// SCR is the compile-time tag of the SCR currency.
+type SCR struct{}
+
+// CurrencyCode returns "SCR".
+func (SCR) CurrencyCode() string { return "SCR" }
+
+// SDG is the compile-time tag of the SDG currency.
+type SDG struct{}
+
+// CurrencyCode returns "SDG".
+func (SDG) CurrencyCode() string { return "SDG" }
+
+// SEK is the compile-time tag of the SEK currency.
+type SEK struct{}
+
+// CurrencyCode returns "SEK".
+func (SEK) CurrencyCode() string { return "SEK" }
+
+// SGD is the compile-time tag of the SGD currency.
+type SGD struct{}
+
+// CurrencyCode returns "SGD".
+func (SGD) CurrencyCode() string { return "SGD" }
+
+// SHP is the compile-time tag of the SHP currency.
+type SHP struct{}
+
+// CurrencyCode returns "SHP".
+func (SHP) CurrencyCode() string { return "SHP" }
+
+// SLE is the compile-time tag of the SLE currency.
+type SLE struct{}
+
+// CurrencyCode returns "SLE".
+func (SLE) CurrencyCode() string { return "SLE" }
+
+// SOS is the compile-time tag of the SOS currency.
+type SOS struct{}
+
+// CurrencyCode returns "SOS".
+func (SOS) CurrencyCode() string { return "SOS" }
+
+// SRD is the compile-time tag of the SRD currency.
+type SRD struct{}
+
+// CurrencyCode returns "SRD".
+func (SRD) CurrencyCode() string { return "SRD" }
+
+// SSP is the compile-time tag of the SSP currency.
+type SSP struct{}
+
+// CurrencyCode returns "SSP".
+func (SSP) CurrencyCode() string { return "SSP" }
+
+// STN is the compile-time tag of the STN currency.
+type STN struct{}
+
+// CurrencyCode returns "STN".
+func (STN) CurrencyCode() string { return "STN" }
+
+// SVC is the compile-time tag of the SVC currency.
+type SVC struct{}
+
+// CurrencyCode returns "SVC".
+func (SVC) CurrencyCode() string { return "SVC" }
+
+// SYP is the compile-time tag of the SYP currency.
+type SYP struct{}
+
+// CurrencyCode returns "SYP".
+func (SYP) CurrencyCode() string { return "SYP" }
+
+// SZL is the compile-time tag of the SZL currency.
+type SZL struct{}
+
+// CurrencyCode returns "SZL".
+func (SZL) CurrencyCode() string { return "SZL" }
+
+// THB is the compile-time tag of the THB currency.
+type THB struct{}
+
+// CurrencyCode returns "THB".
+func (THB) CurrencyCode() string { return "THB" }
+
+// TJS is the compile-time tag of the TJS currency.
+type TJS struct{}
+
+// CurrencyCode returns "TJS".
+func (TJS) CurrencyCode() string { return "TJS" }
+
+// TMT is the compile-time tag of the TMT currency.
+type TMT struct{}
+
+// CurrencyCode returns "TMT".
+func (TMT) CurrencyCode() string { return "TMT" }
+
+// TND is the compile-time tag of the TND currency.
+type TND struct{}
+
+// CurrencyCode returns "TND".
+func (TND) CurrencyCode() string { return "TND" }
+
+// TOP is the compile-time tag of the TOP currency.
+type TOP struct{}
+
+// CurrencyCode returns "TOP".
+func (TOP) CurrencyCode() string { return "TOP" }
+
+// TRY is the compile-time tag of the TRY currency.
+type TRY struct{}
+
+// CurrencyCode returns "TRY".
+func (TRY) CurrencyCode() string { return "TRY" }
+
+// TTD is the compile-time tag of the TTD currency.
+type TTD struct{}
+
+// CurrencyCode returns "TTD".
+func (TTD) CurrencyCode() string { return "TTD" }
+
+// TWD is the compile-time tag of the TWD currency.
+type TWD struct{}
+
+// CurrencyCode returns "TWD".
+func (TWD) CurrencyCode() string { return "TWD" }
+
+// TZS is the compile-time tag of the TZS currency.
+type TZS struct{}
+
+// CurrencyCode returns "TZS".
+func (TZS) CurrencyCode() string { return "TZS" }
+
+// UAH is the compile-time tag of the UAH currency.
+type UAH struct{}
+
+// CurrencyCode returns "UAH".
+func (UAH) CurrencyCode() string { return "UAH" }
+
+// UGX is the compile-time tag of the UGX currency.
+type UGX struct{}
+
+// CurrencyCode returns "UGX".
+func (UGX) CurrencyCode() string { return "UGX" }
+
+// USD is the compile-time tag of the USD currency.
+type USD struct{}
+
+// CurrencyCode returns "USD".
+func (USD) CurrencyCode() string { return "USD" }
+
+// USN is the compile-time tag of the USN currency.
+type USN struct{}
+
+// CurrencyCode returns "USN".
+func (USN) CurrencyCode() string { return "USN" }
+
+// UYI is the compile-time tag of the UYI currency.
+type UYI struct{}
+
+// CurrencyCode returns "UYI".
+func (UYI) CurrencyCode() string { return "UYI" }
+
+// UYU is the compile-time tag of the UYU currency.
+type UYU struct{}
+
+// CurrencyCode returns "UYU".
+func (UYU) CurrencyCode() string { return "UYU" }
+
+// UYW is the compile-time tag of the UYW currency.
+type UYW struct{}
+
+// CurrencyCode returns "UYW".
+func (UYW) CurrencyCode() string { return "UYW" }
+
+// UZS is the compile-time tag of the UZS currency.
+type UZS struct{}
+
+// CurrencyCode returns "UZS".
+func (UZS) CurrencyCode() string { return "UZS" }
+
+// VED is the compile-time tag of the VED currency.
+type VED struct{}
+
+// CurrencyCode returns "VED".
+func (VED) CurrencyCode() string { return "VED" }
+
+// VES is the compile-time tag of the VES currency.
+type VES struct{}
+
+// CurrencyCode returns "VES".
+func (VES) CurrencyCode() string { return "VES" }
+
+// VND is the compile-time tag of the VND currency.
+type VND struct{}
+
+// CurrencyCode returns "VND".
+func (VND) CurrencyCode() string { return "VND" }
+
+// VUV is the compile-time tag of the VUV currency.
+type VUV struct{}
+
+// CurrencyCode returns "VUV".
+func (VUV) CurrencyCode() string { return "VUV" }
+
+// WST is the compile-time tag of the WST currency.
+type WST struct{}
+
+// CurrencyCode returns "WST".
+func (WST) CurrencyCode() string { return "WST" }
+
+// XAD is the compile-time tag of the XAD currency.
+type XAD struct{}
+
+// CurrencyCode returns "XAD".
+func (XAD) CurrencyCode() string { return "XAD" }
+
+// XAF is the compile-time tag of the XAF currency.
+type XAF struct{}
+
+// CurrencyCode returns "XAF".
+func (XAF) CurrencyCode() string { return "XAF" }
+
+// XAG is the compile-time tag of the XAG currency.
+type XAG struct{}
+
+// CurrencyCode returns "XAG".
+func (XAG) CurrencyCode() string { return "XAG" }
+
+// XAU is the compile-time tag of the XAU currency.
+type XAU struct{}
+
+// CurrencyCode returns "XAU".
+func (XAU) CurrencyCode() string { return "XAU" }
+
+// XBA is the compile-time tag of the XBA currency.
+type XBA struct{}
+
+// CurrencyCode returns "XBA".
+func (XBA) CurrencyCode() string { return "XBA" }
+
+// XBB is the compile-time tag of the XBB currency.
+type XBB struct{}
+
+// CurrencyCode returns "XBB".
+func (XBB) CurrencyCode() string { return "XBB" }
+
+// XBC is the compile-time tag of the XBC currency.
+type XBC struct{}
+
+// CurrencyCode returns "XBC".
+func (XBC) CurrencyCode() string { return "XBC" }
+
+// XBD is the compile-time tag of the XBD currency.
+type XBD struct{}
+
+// CurrencyCode returns "XBD".
+func (XBD) CurrencyCode() string { return "XBD" }
+
+// XCD is the compile-time tag of the XCD currency.
+type XCD struct{}
+
+// CurrencyCode returns "XCD".
+func (XCD) CurrencyCode() string { return "XCD" }
+
+// XCG is the compile-time tag of the XCG currency.
+type XCG struct{}
+
+// CurrencyCode returns "XCG".
+func (XCG) CurrencyCode() string { return "XCG" }
+
+// XDR is the compile-time tag of the XDR currency.
+type XDR struct{}
+
+// CurrencyCode returns "XDR".
+func (XDR) CurrencyCode() string { return "XDR" }
+
+// XOF is the compile-time tag of the XOF currency.
+type XOF struct{}
+
+// CurrencyCode returns "XOF".
+func (XOF) CurrencyCode() string { return "XOF" }
+
+// XPD is the compile-time tag of the XPD currency.
+type XPD struct{}
+
+// CurrencyCode returns "XPD".
+func (XPD) CurrencyCode() string { return "XPD" }
+
+// XPF is the compile-time tag of the XPF currency.
+type XPF struct{}
+
+// CurrencyCode returns "XPF".
+func (XPF) CurrencyCode() string { return "XPF" }
+
+// XPT is the compile-time tag of the XPT currency.
+type XPT struct{}
+
+// CurrencyCode returns "XPT".
+func (XPT) CurrencyCode() string { return "XPT" }
+
+// XSU is the compile-time tag of the XSU currency.
+type XSU struct{}
+
+// CurrencyCode returns "XSU".
+func (XSU) CurrencyCode() string { return "XSU" }
+
+// XTS is the compile-time tag of the XTS currency.
+type XTS struct{}
+
+// CurrencyCode returns "XTS".
+func (XTS) CurrencyCode() string { return "XTS" }
+
+// XUA is the compile-time tag of the XUA currency.
+type XUA struct{}
+
+// CurrencyCode returns "XUA".
+func (XUA) CurrencyCode() string { return "XUA" }
+
+// XXX is the compile-time tag of the XXX currency.
+type XXX struct{}
+
+// CurrencyCode returns "XXX".
+func (XXX) CurrencyCode() string { return "XXX" }
+
+// YER is the compile-time tag of the YER currency.
+type YER struct{}
+
+// CurrencyCode returns "YER".
+func (YER) CurrencyCode() string { return "YER" }
+
+// ZAR is the compile-time tag of the ZAR currency.
+type ZAR struct{}
+
+// CurrencyCode returns "ZAR".
+func (ZAR) CurrencyCode() string { return "ZAR" }
+
+// ZMW is the compile-time tag of the ZMW currency.
+type ZMW struct{}
+
+// CurrencyCode returns "ZMW".
+func (ZMW) CurrencyCode() string { return "ZMW" }
+
+// ZWG is the compile-time tag of the ZWG currency.
+type ZWG struct{}
+
+// CurrencyCode returns "ZWG".
+func (ZWG) CurrencyCode() string { return "ZWG" }
@@ -0,0 +1,228 @@
+package iso4217
+
+import (
+	"testing"
+
+	"github.com/nucleus-proj/goodmoney/goodmoney"
+)
+
+var allTags = []goodmoney.CurrencyTag{
+	AED{},
+	AFN{},
+	ALL{},
+	AMD{},
+	AOA{},
+	ARS{},
+	AUD{},
+	AWG{},
+	AZN{},
+	BAM{},
+	BBD{},
+	BDT{},
+	BGN{},
+	BHD{},
+	BIF{},
+	BMD{},
+	BND{},
+	BOB{},
+	BOV{},
+	BRL{},
+	BSD{},
+	BTN{},
+	BWP{},
+	BYN{},
+	BZD{},
+	CAD{},
+	CDF{},
+	CHE{},
+	CHF{},
+	CHW{},
+	CLF{},
+	CLP{},
+	CNY{},
+	COP{},
+	COU{},
+	CRC{},
+	CUP{},
+	CVE{},
+	CZK{},
+	DJF{},
+	DKK{},
+	DOP{},
+	DZD{},
+	EGP{},
+	ERN{},
+	ETB{},
+	EUR{},
+	FJD{},
+	FKP{},
+	GBP{},
+	GEL{},
+	GHS{},
+	GIP{},
+	GMD{},
+	GNF{},
+	GTQ{},
+	GYD{},
+	HKD{},
+	HNL{},
+	HTG{},
+	HUF{},
+	IDR{},
+	ILS{},
+	INR{},
+	IQD{},
+	IRR{},
+	ISK{},
+	JMD{},
+	JOD{},
+	JPY{},
+	KES{},
+	KGS{},
+	KHR{},
+	KMF{},
+	KPW{},
+	KRW{},
+	KWD{},
+	KYD{},
+	KZT{},
+	LAK{},
+	LBP{},
+	LKR{},
+	LRD{},
+	LSL{},
+	LYD{},
+	MAD{},
+	MDL{},
+	MGA{},
+	MKD{},
+	MMK{},
+	MNT{},
+	MOP{},
+	MRU{},
+	MUR{},
+	MVR{},
+	MWK{},
+	MXN{},
+	MXV{},
+	MYR{},
+	MZN{},
+	NAD{},
+	NGN{},
+	NIO{},
+	NOK{},
+	NPR{},
+	NZD{},
+	OMR{},
+	PAB{},
+	PEN{},
+	PGK{},
+	PHP{},
+	PKR{},
+	PLN{},
+	PYG{},
+	QAR{},
+	RON{},
+	RSD{},
+	RUB{},
+	RWF{},
+	SAR{},
+	SBD{},
+	SCR{},
+	SDG{},
+	SEK{},
+	SGD{},
+	SHP{},
+	SLE{},
+	SOS{},
+	SRD{},
+	SSP{},
+	STN{},
+	SVC{},
+	SYP{},
+	SZL{},
+	THB{},
+	TJS{},
+	TMT{},
+	TND{},
+	TOP{},
+	TRY{},
+	TTD{},
+	TWD{},
+	TZS{},
+	UAH{},
+	UGX{},
+	USD{},
+	USN{},
+	UYI{},
+	UYU{},
+	UYW{},
+	UZS{},
+	VED{},
+	VES{},
+	VND{},
+	VUV{},
+	WST{},
+	XAD{},
+	XAF{},
+	XAG{},
+	XAU{},
+	XBA{},
+	XBB{},
+	XBC{},
+	XBD{},
+	XCD{},
+	XCG{},
+	XDR{},
+	XOF{},
+	XPD{},
+	XPF{},
+	XPT{},
+	XSU{},
+	XTS{},
+	XUA{},
+	XXX{},
+	YER{},
+	ZAR{},
+	ZMW{},
+	ZWG{},
+}
+
+func TestTagsMatchCurrencyMap(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[string]bool, len(allTags))
+	for _, tag := range allTags {
+		code := tag.CurrencyCode()
+		if !goodmoney.ValidateCurrency(code) {
+			t.Errorf("%T.CurrencyCode() = %q, not in CurrencyMap", tag, code)
+		}
+		if seen[code] {
+			t.Errorf("currency code %q is tagged twice", code)
+		}
+		seen[code] = true
+	}
+
+	for code := range goodmoney.CurrencyMap {
+		if !seen[code] {
+			t.Errorf("currency %q has no tag type", code)
+		}
+	}
+}
+
+func TestTagWithAmount(t *testing.T) {
+	t.Parallel()
+
+	price, err := goodmoney.AmountFromString[USD]("19.99")
+	if err != nil {
+		t.Fatalf("AmountFromString() unexpected error: %v", err)
+	}
+	if got := price.String(); got != "19.99 USD" {
+		t.Errorf("String() = %q, want %q", got, "19.99 USD")
+	}
+
+	yen := goodmoney.AmountFromMinorUnits[JPY](1500)
+	if got := yen.String(); got != "1500 JPY" {
+		t.Errorf("String() = %q, want %q", got, "1500 JPY")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
+	"strconv"
 
 	"golang.org/x/text/language"
 )
@@ -25,8 +27,20 @@ var (
 
 	// ErrUnderflow happens when an arithmetic operation would exceed int64 minimum value.
 	ErrUnderflow = errors.New("amount underflow")
+
+	// ErrInvalidAmount happens when an amount is malformed, e.g. a decimal string that isn't a number.
+	ErrInvalidAmount = errors.New("invalid amount")
+
+	// ErrPrecisionLoss happens when a float64 amount is too large to identify its minor units exactly.
+	ErrPrecisionLoss = errors.New("amount cannot be represented exactly as float64")
+
+	// ErrDivisionByZero happens when dividing by zero.
+	ErrDivisionByZero = errors.New("division by zero")
 )
 
+// maxExactFloat is 2^53, the largest magnitude below which float64 holds every integer.
+const maxExactFloat = 1 << 53
+
 // RoundScheme defines different rounding schemes
 type RoundScheme int
 
@@ -49,12 +63,17 @@ const (
 
 type Money struct {
 	amount   int64
-	currency *Currency
+	currency *currencyEntry
 }
 
 // New creates a new Money instance from a float64 amount and currency code.
 // Returns an error if the currency code is invalid or the amount has too many decimal places.
 //
+// The amount is read through its shortest decimal representation, so New(19.99, "USD")
+// is exactly 1999 cents. Residual binary noise from float arithmetic (0.1 + 0.2) is tolerated.
+// Beyond 2^53 minor units a float64 can't tell adjacent cents apart; use NewFromString or
+// NewFromFloat, which reports ErrPrecisionLoss, when that matters.
+//
 // Example:
 //
 //	m, err := New(100.50, "USD")
@@ -62,42 +81,181 @@ type Money struct {
 //	    // handle error
 //	}
 func New(amount float64, currencyCode string) (*Money, error) {
-	c, err := getCurrency(currencyCode)
+	c, err := lookupCurrency(currencyCode)
 	if err != nil {
 		return nil, err
 	}
 
-	// validate minor unit length of float
-	multiplier := math.Pow10(c.MinorUnit)
-	amountNormalized := amount * multiplier
-	// A. Truncate the normalized amount to get the integer part.
-	integerPart := math.Trunc(amountNormalized)
+	units, err := floatToMinorUnits(amount, c.MinorUnit)
+	if err == ErrTooManyDecimalPlaces {
+		// Compare the fractional part against a small tolerance (epsilon)
+		// to accept results of float arithmetic such as 0.1 + 0.2.
+		amountNormalized := amount * math.Pow10(c.MinorUnit)
+		rounded := math.Round(amountNormalized)
+		if math.Abs(amountNormalized-rounded) > 1e-9 {
+			return nil, ErrTooManyDecimalPlaces
+		}
+		units, err = int64(rounded), nil
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	// B. Calculate the absolute difference (the fractional part).
-	// e.g., 123.45 - 123.0 = 0.45
-	fractionalPart := math.Abs(amountNormalized - integerPart)
+	return &Money{
+		amount:   units,
+		currency: c,
+	}, nil
+}
 
-	// C. Compare the fractional part against a small tolerance (epsilon).
-	// This is crucial for robust floating-point comparisons.
-	// We use a small epsilon (1e-9) to account for binary float imprecision.
-	epsilon := 1e-9
+// NewFromFloat creates a new Money instance from a float64 amount like New, but without
+// any tolerance: the shortest decimal representation of amount must fit the currency's
+// minor unit. Returns ErrPrecisionLoss if the amount is 2^53 minor units or more,
+// where float64 can no longer represent every cent.
+//
+// Example:
+//
+//	m, err := NewFromFloat(19.99, "USD")           // 1999 cents
+//	_, err = NewFromFloat(0.1+0.2, "USD")           // ErrTooManyDecimalPlaces
+//	_, err = NewFromFloat(90071992547409.93, "USD") // ErrPrecisionLoss
+func NewFromFloat(amount float64, currencyCode string) (*Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	if math.Abs(amount)*math.Pow10(c.MinorUnit) >= maxExactFloat {
+		return nil, ErrPrecisionLoss
+	}
 
-	// If the fractionalPart is greater than the epsilon,
-	// it means there's a significant non-zero part remaining,
-	// and the original 'amount' had too many decimal places.
+	units, err := floatToMinorUnits(amount, c.MinorUnit)
+	if err != nil {
+		return nil, err
+	}
 
-	if fractionalPart > epsilon {
-		return nil, ErrTooManyDecimalPlaces
+	return &Money{
+		amount:   units,
+		currency: c,
+	}, nil
+}
+
+// NewFromString creates a new Money instance from a decimal string such as "1234.56" or "-0.5".
+// The conversion is exact and never goes through float64. Trailing zeros beyond the currency's
+// minor unit are accepted ("10.500" USD), any other extra digit returns ErrTooManyDecimalPlaces.
+// Returns ErrInvalidAmount for malformed input and ErrOverflow or ErrUnderflow if the amount
+// doesn't fit in int64 minor units.
+//
+// Example:
+//
+//	m, err := NewFromString("12345678901234.56", "USD")
+func NewFromString(amount string, currencyCode string) (*Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := parseDecimal(amount, c.MinorUnit)
+	if err != nil {
+		return nil, err
 	}
 
-	amountInMinorUnits := int64(amount * multiplier)
+	return &Money{
+		amount:   units,
+		currency: c,
+	}, nil
+}
+
+// NewFromMinorUnits creates a new Money instance from an amount already expressed in
+// minor units (e.g., cents for USD, fils for BHD).
+//
+// Example:
+//
+//	m, err := NewFromMinorUnits(1050, "USD") // $10.50
+func NewFromMinorUnits(units int64, currencyCode string) (*Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{
+		amount:   units,
+		currency: c,
+	}, nil
+}
+
+// NewFromMajorMinor creates a new Money instance from separate major and minor parts,
+// mirroring MajorUnit and MinorUnit: the result is major*10^MinorUnit + minor.
+// The minor part must be smaller in magnitude than one major unit and must not
+// have the opposite sign of the major part. Returns ErrInvalidAmount otherwise.
+//
+// Example:
+//
+//	m, err := NewFromMajorMinor(10, 50, "USD")   // $10.50
+//	m, err = NewFromMajorMinor(-10, -50, "USD")  // -$10.50
+func NewFromMajorMinor(major, minor int64, currencyCode string) (*Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	multiplier := pow10[c.MinorUnit]
+	if minor <= -multiplier || minor >= multiplier || (major > 0 && minor < 0) || (major < 0 && minor > 0) {
+		return nil, ErrInvalidAmount
+	}
+
+	units, err := multiplyInt64(major, multiplier)
+	if err != nil {
+		return nil, err
+	}
+	units, err = addInt64(units, minor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{
+		amount:   units,
+		currency: c,
+	}, nil
+}
+
+// NewFromRat creates a new Money instance from an exact rational amount in major units.
+// Returns ErrTooManyDecimalPlaces if the value isn't a whole number of minor units,
+// and ErrOverflow or ErrUnderflow if it doesn't fit in int64 minor units.
+//
+// Example:
+//
+//	m, err := NewFromRat(big.NewRat(21, 2), "USD") // $10.50
+func NewFromRat(amount *big.Rat, currencyCode string) (*Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	if amount == nil {
+		return nil, ErrInvalidAmount
+	}
+
+	scaled := new(big.Rat).SetInt(bigPow10(c.MinorUnit))
+	scaled.Mul(scaled, amount)
+	if !scaled.IsInt() {
+		return nil, ErrTooManyDecimalPlaces
+	}
+	if !scaled.Num().IsInt64() {
+		return nil, overflowError(scaled.Sign() < 0)
+	}
 
 	return &Money{
-		amount:   amountInMinorUnits,
-		currency: &c,
+		amount:   scaled.Num().Int64(),
+		currency: c,
 	}, nil
 }
 
+// floatToMinorUnits converts amount to minor units through its shortest decimal representation.
+func floatToMinorUnits(amount float64, minorUnit int) (int64, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, ErrInvalidAmount
+	}
+	var buf [64]byte
+	return parseDecimal(strconv.AppendFloat(buf[:0], amount, 'f', -1, 64), minorUnit)
+}
+
 // NewZero creates a new Money instance with zero amount for the given currency code.
 // Returns an error if the currency code is invalid.
 //
@@ -152,7 +310,7 @@ func (m Money) Amount() float64 {
 // Returns an error if currencies don't match.
 func (m Money) Compare(om *Money) (int, error) {
 	//validate currency mismatch
-	if m.currency == nil || om.currency == nil || m.currency.NumericCode != om.currency.NumericCode {
+	if m.currency == nil || !m.currency.sameAs(om.currency) {
 		return 0, ErrCurrencyMismatch
 	}
 
@@ -171,14 +329,13 @@ func (m Money) Currency() string {
 	if m.currency == nil {
 		return ""
 	}
-	_, code, _ := GetCurrencyByNumericCode(m.currency.NumericCode)
-	return code
+	return m.currency.code
 }
 
 // check if equal
 func (m Money) Equals(om *Money) (bool, error) {
 	//validate currency mismatch
-	if m.currency == nil || om.currency == nil || m.currency.NumericCode != om.currency.NumericCode {
+	if m.currency == nil || !m.currency.sameAs(om.currency) {
 		return false, ErrCurrencyMismatch
 	}
 	if om.amount == m.amount {
@@ -260,33 +417,11 @@ func (m Money) IsZero() bool {
 func (m Money) Multiply(ms ...int64) (*Money, error) {
 	result := m.amount
 	for _, multiplier := range ms {
-		// Check for overflow before multiplication
-		if result != 0 && multiplier != 0 {
-			// Check positive overflow (result * multiplier > MaxInt64)
-			if result > 0 && multiplier > 0 {
-				if result > math.MaxInt64/multiplier {
-					return nil, ErrOverflow
-				}
-			}
-			// Check negative overflow (result * multiplier < MinInt64)
-			if result > 0 && multiplier < 0 {
-				if multiplier < math.MinInt64/result {
-					return nil, ErrUnderflow
-				}
-			}
-			if result < 0 && multiplier > 0 {
-				if result < math.MinInt64/multiplier {
-					return nil, ErrUnderflow
-				}
-			}
-			// For negative * negative, check if result would overflow MaxInt64
-			if result < 0 && multiplier < 0 {
-				if result < math.MaxInt64/multiplier {
-					return nil, ErrOverflow
-				}
-			}
+		var err error
+		result, err = multiplyInt64(result, multiplier)
+		if err != nil {
+			return nil, err
 		}
-		result *= multiplier
 	}
 	return &Money{
 		amount:   result,
@@ -294,8 +429,10 @@ func (m Money) Multiply(ms ...int64) (*Money, error) {
 	}, nil
 }
 
-// Divide divides the money amount by one or more int64 divisors.
-// Returns an error if any divisor is zero.
+// Divide divides the money amount by one or more int64 divisors, truncating toward
+// zero after each division. Use DivideRound to choose the rounding or QuoRem to keep
+// the remainder.
+// Returns ErrDivisionByZero if any divisor is zero.
 //
 // Example:
 //
@@ -305,7 +442,10 @@ func (m Money) Divide(ds ...int64) (*Money, error) {
 	result := m.amount
 	for _, divisor := range ds {
 		if divisor == 0 {
-			return nil, errors.New("division by zero")
+			return nil, ErrDivisionByZero
+		}
+		if divisor == -1 && result == math.MinInt64 {
+			return nil, ErrOverflow
 		}
 		result /= divisor
 	}
@@ -315,6 +455,58 @@ func (m Money) Divide(ds ...int64) (*Money, error) {
 	}, nil
 }
 
+// QuoRem divides m by d and returns the quotient truncated toward zero and the
+// remainder, which has the sign of m: quotient*d + remainder == m, no minor unit is lost.
+// Returns ErrDivisionByZero if d is zero and ErrOverflow for the minimum int64 divided by -1.
+//
+// Example:
+//
+//	fee, _ := NewFromString("100.00", USD)
+//	share, rest, _ := fee.QuoRem(3)  // 33.33 USD, 0.01 USD
+func (m Money) QuoRem(d int64) (*Money, *Money, error) {
+	if d == 0 {
+		return nil, nil, ErrDivisionByZero
+	}
+	if d == -1 && m.amount == math.MinInt64 {
+		return nil, nil, ErrOverflow
+	}
+	return &Money{amount: m.amount / d, currency: m.currency},
+		&Money{amount: m.amount % d, currency: m.currency}, nil
+}
+
+// DivideRound divides m by d and rounds the exact quotient to the minor unit with
+// the given scheme.
+// Returns ErrDivisionByZero if d is zero and ErrOverflow for the minimum int64 divided by -1.
+//
+// Example:
+//
+//	fee, _ := NewFromString("0.05", USD)
+//	half, _ := fee.DivideRound(2, RoundHalfEven)  // 0.02 USD
+func (m Money) DivideRound(d int64, scheme RoundScheme) (*Money, error) {
+	return m.DivideRat(d, 1, scheme)
+}
+
+// DivideRat divides m by the rational num/den and rounds the exact quotient to the
+// minor unit with the given scheme. The product of m and den is computed on 128 bits,
+// so it never overflows by itself.
+// Returns ErrDivisionByZero if num or den is zero, and ErrOverflow or ErrUnderflow
+// if the quotient doesn't fit in int64.
+//
+// Example:
+//
+//	price, _ := NewFromString("100.00", EUR)
+//	net, _ := price.DivideRat(6, 5, RoundHalfUp)  // 83.33 EUR, price without 20% VAT
+func (m Money) DivideRat(num, den int64, scheme RoundScheme) (*Money, error) {
+	if den == 0 {
+		return nil, ErrDivisionByZero
+	}
+	units, err := mulDivRound(m.amount, den, num, scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &Money{amount: units, currency: m.currency}, nil
+}
+
 // get negative of amount
 func (m Money) Negative() *Money {
 	return &Money{
@@ -323,78 +515,90 @@ func (m Money) Negative() *Money {
 	}
 }
 
-// round with the specified rounding scheme
+// round to whole major units with the specified rounding scheme, see RoundTo for other scales
 // Defaults to RoundTowardZero if scheme is nil
+//
+// Amounts within a major unit of the int64 limits whose rounding away from zero
+// doesn't fit are truncated instead, so Round(&RoundCeiling) of the largest amounts
+// rounds down. Use RoundTo(0, scheme) or RoundToIncrement, which return ErrOverflow
+// or ErrUnderflow instead, when the direction matters.
 func (m Money) Round(scheme *RoundScheme) *Money {
-	if m.currency == nil {
-		return &Money{
-			amount:   m.amount,
-			currency: nil,
-		}
-	}
-
 	// Default to RoundTowardZero if no scheme provided
 	roundScheme := RoundTowardZero
 	if scheme != nil {
 		roundScheme = *scheme
 	}
 
-	// Convert to float64, apply rounding scheme, then convert back
-	amountFloat := m.Amount()
-	roundedAmount := applyRoundScheme(amountFloat, roundScheme)
-
-	// Convert back to minor units
-	multiplier := math.Pow10(m.currency.MinorUnit)
-	amountInMinorUnits := int64(roundedAmount * multiplier)
-
 	return &Money{
-		amount:   amountInMinorUnits,
+		amount:   m.roundedAmount(roundScheme),
 		currency: m.currency,
 	}
 }
 
-// applyRoundScheme applies the specified rounding scheme to a float64 value
-func applyRoundScheme(amount float64, scheme RoundScheme) float64 {
-	switch scheme {
-	case RoundHalfUp:
-		if amount >= 0 {
-			return math.Floor(amount + 0.5)
-		}
-		return math.Ceil(amount + 0.5)
-	case RoundHalfDown:
-		if amount >= 0 {
-			return math.Ceil(amount - 0.5)
-		}
-		return math.Floor(amount - 0.5)
-	case RoundTowardZero:
-		return math.Trunc(amount)
-	case RoundAwayFromZero:
-		if amount >= 0 {
-			return math.Ceil(amount)
-		}
-		return math.Floor(amount)
-	case RoundHalfEven:
-		// Banker's rounding: round to nearest even
-		floor := math.Floor(amount)
-		frac := amount - floor
-		if frac < 0.5 {
-			return floor
-		}
-		if frac > 0.5 {
-			return floor + 1
-		}
-		// Exactly 0.5 - round to nearest even
-		if int64(floor)%2 == 0 {
-			return floor
+// roundedAmount returns the amount rounded to whole major units with the given scheme.
+// Amounts within a major unit of the int64 limits that can't be rounded away from
+// zero are truncated instead, as documented on Round.
+func (m Money) roundedAmount(scheme RoundScheme) int64 {
+	if m.currency == nil {
+		return m.amount
+	}
+
+	increment := pow10[m.currency.MinorUnit]
+	rounded, err := roundUnits(m.amount, increment, scheme)
+	if err != nil {
+		rounded, _ = roundUnits(m.amount, increment, RoundTowardZero)
+	}
+	return rounded
+}
+
+// RoundTo rounds m to the given number of decimal places with the given scheme,
+// exactly and without going through float64. Negative decimals round to tens,
+// hundreds and so on. Rounding to as many decimals as the currency has or more
+// returns m unchanged.
+// Returns ErrOverflow or ErrUnderflow if the rounded amount doesn't fit in int64,
+// including increments beyond int64; unlike Round it never truncates instead.
+//
+// Example:
+//
+//	m, _ := NewFromString("1234.567", KWD)
+//	m.RoundTo(2, RoundHalfEven)  // 1234.570 KWD
+//	m.RoundTo(-2, RoundHalfUp)   // 1200.000 KWD
+func (m Money) RoundTo(decimals int, scheme RoundScheme) (*Money, error) {
+	exponent := m.scale() - decimals
+	if exponent <= 0 {
+		return &Money{amount: m.amount, currency: m.currency}, nil
+	}
+	if exponent >= len(pow10) {
+		// the increment exceeds any amount: the result is zero or out of range
+		units := roundRat(new(big.Rat).SetFrac(big.NewInt(m.amount), bigPow10(exponent)), scheme)
+		if units.Sign() != 0 {
+			return nil, overflowError(units.Sign() < 0)
 		}
-		return floor + 1
-	case RoundCeiling:
-		return math.Ceil(amount)
-	case RoundFloor:
-		return math.Floor(amount)
-	default:
-		return math.Trunc(amount)
+		return &Money{amount: 0, currency: m.currency}, nil
+	}
+	return m.RoundToIncrement(pow10[exponent], scheme)
+}
+
+// RoundToIncrement rounds m to a multiple of increment, given in minor units, with
+// the given scheme: 5 rounds USD to the nearest 0.05, 1000 to the nearest 10.00.
+// Ties follow the same conventions as Round.
+// Returns ErrInvalidAmount if increment isn't positive, and ErrOverflow or
+// ErrUnderflow if the rounded amount doesn't fit in int64; unlike Round it never
+// truncates instead.
+//
+// Example:
+//
+//	m, _ := NewFromString("12.43", CHF)
+//	m.RoundToIncrement(5, RoundHalfUp)  // 12.45 CHF
+func (m Money) RoundToIncrement(increment int64, scheme RoundScheme) (*Money, error) {
+	if increment <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	rounded, err := roundUnits(m.amount, increment, scheme)
+	if err != nil {
+		return nil, err
 	}
+	return &Money{amount: rounded, currency: m.currency}, nil
 }
 
 // Add adds two or more Money values together.
@@ -415,7 +619,7 @@ func Add(ms ...*Money) (*Money, error) {
 		return nil, ErrCurrencyMismatch
 	}
 
-	referenceCurrency := firstMoney.currency.NumericCode
+	referenceCurrency := firstMoney.currency
 	result := firstMoney.amount
 
 	// optimize: early return for single Money
@@ -429,17 +633,14 @@ func Add(ms ...*Money) (*Money, error) {
 	// validate and sum remaining money values
 	for i := 1; i < len(ms); i++ {
 		money := ms[i]
-		if money == nil || money.currency == nil || money.currency.NumericCode != referenceCurrency {
+		if money == nil || money.currency == nil || !money.currency.sameAs(referenceCurrency) {
 			return nil, ErrCurrencyMismatch
 		}
-		// Check for overflow before addition
-		if money.amount > 0 && result > math.MaxInt64-money.amount {
-			return nil, ErrOverflow
+		var err error
+		result, err = addInt64(result, money.amount)
+		if err != nil {
+			return nil, err
 		}
-		if money.amount < 0 && result < math.MinInt64-money.amount {
-			return nil, ErrUnderflow
-		}
-		result += money.amount
 	}
 
 	return &Money{
@@ -460,23 +661,20 @@ func (m Money) Subtract(ms ...*Money) (*Money, error) {
 		return nil, ErrNeedAtLeastOneMoney
 	}
 
-	referenceCurrency := m.currency.NumericCode
+	referenceCurrency := m.currency
 	result := m.amount
 
 	// validate and subtract all money values
 	for i := 0; i < len(ms); i++ {
 		money := ms[i]
-		if money == nil || money.currency == nil || money.currency.NumericCode != referenceCurrency {
+		if money == nil || money.currency == nil || !money.currency.sameAs(referenceCurrency) {
 			return nil, ErrCurrencyMismatch
 		}
-		// Check for overflow before subtraction
-		if money.amount < 0 && result > math.MaxInt64+money.amount {
-			return nil, ErrOverflow
-		}
-		if money.amount > 0 && result < math.MinInt64+money.amount {
-			return nil, ErrUnderflow
+		var err error
+		result, err = subtractInt64(result, money.amount)
+		if err != nil {
+			return nil, err
 		}
-		result -= money.amount
 	}
 
 	return &Money{
@@ -486,7 +684,8 @@ func (m Money) Subtract(ms ...*Money) (*Money, error) {
 }
 
 // Allocate splits money by given ratios without losing pennies.
-// Leftover pennies are distributed amongst the parties using round-robin principle.
+// Leftover pennies are distributed amongst the parties using round-robin principle,
+// use AllocateWith to choose another strategy.
 //
 // Example:
 //
@@ -548,7 +747,9 @@ func (m *Money) Allocate(rs ...int) ([]*Money, error) {
 }
 
 // AllocateByPercentage splits money by given percentages without losing pennies.
-// Leftover pennies are distributed amongst the parties using round-robin principle.
+// Leftover pennies are distributed amongst the parties using round-robin principle,
+// use AllocateByPercentWith to choose another strategy. Proportions go through float64,
+// AllocateByPercentStrings computes them exactly.
 //
 // Example:
 //
@@ -621,13 +822,14 @@ func (m Money) String() string {
 	}
 
 	currencyCode := m.Currency()
-	amount := m.Amount()
 
 	// Format amount based on currency's minor unit precision
-	formatStr := fmt.Sprintf("%%.%df", m.currency.MinorUnit)
-	formattedAmount := fmt.Sprintf(formatStr, amount)
+	var buf [32]byte
+	b := m.AppendDecimal(buf[:0])
+	b = append(b, ' ')
+	b = append(b, currencyCode...)
 
-	return fmt.Sprintf("%s %s", formattedAmount, currencyCode)
+	return string(b)
 }
 
 // MajorUnit returns the major unit portion of the money (e.g., dollars for USD).
@@ -636,7 +838,7 @@ func (m Money) MajorUnit() int64 {
 	if m.currency == nil {
 		return 0
 	}
-	multiplier := int64(math.Pow10(m.currency.MinorUnit))
+	multiplier := pow10[m.currency.MinorUnit]
 	return m.amount / multiplier
 }
 
@@ -646,10 +848,44 @@ func (m Money) MinorUnit() int64 {
 	if m.currency == nil {
 		return 0
 	}
-	multiplier := int64(math.Pow10(m.currency.MinorUnit))
+	multiplier := pow10[m.currency.MinorUnit]
 	return m.amount % multiplier
 }
 
+// MinorUnits returns the whole amount expressed in minor units (e.g., cents for USD).
+// For $100.50, this returns 10050.
+func (m Money) MinorUnits() int64 {
+	return m.amount
+}
+
+// DecimalString returns the exact amount as a plain decimal string with as many
+// fractional digits as the currency's minor unit, without the currency code.
+// For $100.50 this returns "100.50", for ¥1500 "1500". It round-trips with NewFromString.
+func (m Money) DecimalString() string {
+	var buf [24]byte
+	return string(m.AppendDecimal(buf[:0]))
+}
+
+// AppendDecimal appends the exact amount as formatted by DecimalString to dst
+// and returns the extended buffer.
+func (m Money) AppendDecimal(dst []byte) []byte {
+	return appendDecimal(dst, m.amount, m.scale())
+}
+
+// Rat returns the exact amount in major units as a new big.Rat.
+// For $100.50, this returns 201/2. It round-trips with NewFromRat.
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(m.amount), big.NewInt(pow10[m.scale()]))
+}
+
+// scale returns the number of decimal places of the currency, 0 if currency is nil.
+func (m Money) scale() int {
+	if m.currency == nil {
+		return 0
+	}
+	return m.currency.MinorUnit
+}
+
 // Format formats the money with the specified locale using standard formatting.
 // It uses locale-aware number formatting with currency symbol.
 //
@@ -692,29 +928,29 @@ func (m Money) FormatWithOptions(opts FormatOptions) string {
 		return fmt.Sprintf("%d (no currency)", m.amount)
 	}
 
+	m, digits := m.withPrecision(opts.Precision)
 	currencyCode := m.Currency()
 	amount := m.Amount()
 	isNegative := m.amount < 0
-	symbol := getCurrencySymbol(m.currency, currencyCode)
-	position := getSymbolPosition(m.currency)
+	symbol := getCurrencySymbol(&m.currency.Currency, currencyCode)
+	position := getSymbolPosition(&m.currency.Currency)
 
 	var result string
 
 	switch opts.Mode {
 	case FormatCode:
 		// Same as String() method - format with currency code
-		formatStr := fmt.Sprintf("%%.%df", m.currency.MinorUnit)
-		formattedAmount := fmt.Sprintf(formatStr, amount)
-		result = fmt.Sprintf("%s %s", formattedAmount, currencyCode)
+		decimals := appendDecimal(nil, m.amount/pow10[m.scale()-digits], digits)
+		result = string(decimals) + " " + currencyCode
 
 	case FormatSymbol:
 		// Format with symbol only (no code)
-		formattedNumber := formatNumber(opts.Locale, amount, m.currency.MinorUnit)
+		formattedNumber := formatNumber(opts.Locale, amount, digits)
 		result = formatWithSymbol(formattedNumber, symbol, position, isNegative)
 
 	case FormatAccounting:
 		// Accounting format with parentheses for negatives
-		formattedNumber := formatNumber(opts.Locale, amount, m.currency.MinorUnit)
+		formattedNumber := formatNumber(opts.Locale, amount, digits)
 		formattedNumber = formatAccounting(formattedNumber, isNegative)
 		// For accounting format, symbol goes outside parentheses
 		if position {
@@ -725,17 +961,17 @@ func (m Money) FormatWithOptions(opts FormatOptions) string {
 
 	case FormatCompact:
 		// Compact notation for large amounts
-		result = formatCompact(amount, symbol, position, m.currency.MinorUnit)
+		result = formatCompact(amount, symbol, position, digits)
 
 	case FormatMinimal:
 		// Minimal format without thousand separators
-		result = formatMinimal(amount, symbol, position, m.currency.MinorUnit, isNegative)
+		result = formatMinimal(amount, symbol, position, digits, isNegative)
 
 	case FormatStandard:
 		fallthrough
 	default:
 		// Standard formatting
-		formattedNumber := formatNumber(opts.Locale, amount, m.currency.MinorUnit)
+		formattedNumber := formatNumber(opts.Locale, amount, digits)
 		result = formatWithSymbol(formattedNumber, symbol, position, isNegative)
 	}
 
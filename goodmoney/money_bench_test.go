@@ -3,9 +3,12 @@ package goodmoney
 import (
 	"encoding/json"
 	"testing"
+
+	"golang.org/x/text/language"
 )
 
 func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = New(100.50, USD)
@@ -13,6 +16,7 @@ func BenchmarkNew(b *testing.B) {
 }
 
 func BenchmarkNewZero(b *testing.B) {
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = NewZero(USD)
@@ -28,6 +32,7 @@ func BenchmarkAmount(b *testing.B) {
 }
 
 func BenchmarkCurrency(b *testing.B) {
+	b.ReportAllocs()
 	m, _ := New(100.50, USD)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -173,6 +178,7 @@ func BenchmarkAllocateByPercentage(b *testing.B) {
 }
 
 func BenchmarkString(b *testing.B) {
+	b.ReportAllocs()
 	m, _ := New(100.50, USD)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -181,6 +187,7 @@ func BenchmarkString(b *testing.B) {
 }
 
 func BenchmarkMarshalJSON(b *testing.B) {
+	b.ReportAllocs()
 	m, _ := New(100.50, USD)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -250,3 +257,122 @@ func BenchmarkJSONUnmarshal(b *testing.B) {
 		_ = json.Unmarshal(data, &m)
 	}
 }
+
+func BenchmarkNewFromMinorUnits(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = NewFromMinorUnits(10050, USD)
+	}
+}
+
+func BenchmarkNewFromString(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = NewFromString("100.50", USD)
+	}
+}
+
+func BenchmarkDecimalString(b *testing.B) {
+	m, _ := New(100.50, USD)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.DecimalString()
+	}
+}
+
+func BenchmarkCurrencyParallel(b *testing.B) {
+	b.ReportAllocs()
+	m, _ := New(100.50, USD)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = m.Currency()
+		}
+	})
+}
+
+func BenchmarkFormat(b *testing.B) {
+	m, _ := New(1234.56, USD)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.FormatWithMode(language.AmericanEnglish, FormatCode)
+	}
+}
+
+func BenchmarkSum(b *testing.B) {
+	items := make([]Money, 1000)
+	for i := range items {
+		items[i], _ = MakeFromMinorUnits(int64(i), USD)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Sum(items...)
+	}
+}
+
+func BenchmarkPlus(b *testing.B) {
+	m1, _ := MakeFromMinorUnits(10050, USD)
+	m2, _ := MakeFromMinorUnits(5025, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m1.Plus(m2)
+	}
+}
+
+func BenchmarkTimes(b *testing.B) {
+	m, _ := MakeFromMinorUnits(10050, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Times(3)
+	}
+}
+
+// Sinks keep benchmarked values from being optimized away.
+var (
+	benchString string
+	benchMoney  *Money
+	benchAny    any
+)
+
+// BenchmarkCurrencyReference compares the interned currency reference of Money with
+// what it replaced: a lookup of the code by numeric code on every Currency() call and
+// a private heap copy of the Currency for every new value.
+func BenchmarkCurrencyReference(b *testing.B) {
+	m, _ := NewFromMinorUnits(10050, USD)
+
+	b.Run("Currency/interned", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchString = m.Currency()
+		}
+	})
+	b.Run("Currency/numeric code lookup", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, code, _ := GetCurrencyByNumericCode(m.currency.NumericCode)
+			benchString = code
+		}
+	})
+	b.Run("New/interned", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchMoney, _ = NewFromMinorUnits(10050, USD)
+		}
+	})
+	b.Run("New/currency copy", func(b *testing.B) {
+		type copiedMoney struct {
+			amount   int64
+			currency *Currency
+		}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c, _ := getCurrency(USD)
+			benchAny = &copiedMoney{amount: 10050, currency: &c}
+		}
+	})
+}
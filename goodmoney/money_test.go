@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 	"testing"
 )
@@ -2133,6 +2134,41 @@ func TestNew(t *testing.T) {
 			wantErr: ErrCurrencyCodeDoesNotExist,
 		},
 
+		{
+			name:       "USD amount not exactly representable in binary",
+			amount:     19.99,
+			code:       USD,
+			wantErr:    nil,
+			wantAmount: 1999,
+		},
+		{
+			name:       "negative USD amount not exactly representable in binary",
+			amount:     -0.29,
+			code:       USD,
+			wantErr:    nil,
+			wantAmount: -29,
+		},
+		{
+			name:       "float arithmetic noise is tolerated",
+			amount:     floatSum(0.1, 0.2),
+			code:       USD,
+			wantErr:    nil,
+			wantAmount: 30,
+		},
+		{
+			name:       "large USD amount keeps its cents",
+			amount:     12345678901234.56,
+			code:       USD,
+			wantErr:    nil,
+			wantAmount: 1234567890123456,
+		},
+		{
+			name:    "NaN amount",
+			amount:  math.NaN(),
+			code:    USD,
+			wantErr: ErrInvalidAmount,
+		},
+
 		// Too many decimal places
 		{
 			name:    "USD with 3 decimals (should fail)",
@@ -4003,3 +4039,584 @@ func TestMinorUnit(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFromFloat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		amount     float64
+		code       string
+		wantAmount int64
+		wantErr    error
+	}{
+		{
+			name:       "USD with 2 decimals",
+			amount:     19.99,
+			code:       USD,
+			wantAmount: 1999,
+		},
+		{
+			name:       "JPY whole amount",
+			amount:     1500,
+			code:       JPY,
+			wantAmount: 1500,
+		},
+		{
+			name:    "float arithmetic noise is rejected",
+			amount:  floatSum(0.1, 0.2),
+			code:    USD,
+			wantErr: ErrTooManyDecimalPlaces,
+		},
+		{
+			name:    "beyond 2^53 minor units",
+			amount:  90071992547409.93,
+			code:    USD,
+			wantErr: ErrPrecisionLoss,
+		},
+		{
+			name:    "negative beyond 2^53 minor units",
+			amount:  -90071992547409.93,
+			code:    USD,
+			wantErr: ErrPrecisionLoss,
+		},
+		{
+			name:    "infinity",
+			amount:  math.Inf(1),
+			code:    USD,
+			wantErr: ErrPrecisionLoss,
+		},
+		{
+			name:    "NaN",
+			amount:  math.NaN(),
+			code:    USD,
+			wantErr: ErrInvalidAmount,
+		},
+		{
+			name:    "invalid currency",
+			amount:  1,
+			code:    "INVALID",
+			wantErr: ErrCurrencyCodeDoesNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewFromFloat(tt.amount, tt.code)
+			if err != tt.wantErr {
+				t.Fatalf("NewFromFloat() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.amount != tt.wantAmount {
+				t.Errorf("NewFromFloat() amount = %d, want %d", got.amount, tt.wantAmount)
+			}
+		})
+	}
+}
+
+func TestNewFromString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		amount     string
+		code       string
+		wantAmount int64
+		wantErr    error
+	}{
+		{name: "USD with 2 decimals", amount: "100.50", code: USD, wantAmount: 10050},
+		{name: "USD beyond 2^53 cents", amount: "12345678901234567.89", code: USD, wantAmount: 1234567890123456789},
+		{name: "USD without fraction", amount: "100", code: USD, wantAmount: 10000},
+		{name: "USD with 1 decimal", amount: "100.5", code: USD, wantAmount: 10050},
+		{name: "USD with trailing zeros", amount: "100.5000", code: USD, wantAmount: 10050},
+		{name: "negative USD", amount: "-0.05", code: USD, wantAmount: -5},
+		{name: "explicit plus sign", amount: "+7.25", code: USD, wantAmount: 725},
+		{name: "leading zeros", amount: "007.10", code: USD, wantAmount: 710},
+		{name: "JPY", amount: "1500", code: JPY, wantAmount: 1500},
+		{name: "JPY with zero fraction", amount: "1500.00", code: JPY, wantAmount: 1500},
+		{name: "BHD with 3 decimals", amount: "1.234", code: BHD, wantAmount: 1234},
+		{name: "max int64 minor units", amount: "92233720368547758.07", code: USD, wantAmount: math.MaxInt64},
+		{name: "min int64 minor units", amount: "-92233720368547758.08", code: USD, wantAmount: math.MinInt64},
+		{name: "too many decimals", amount: "100.505", code: USD, wantErr: ErrTooManyDecimalPlaces},
+		{name: "JPY with fraction", amount: "1500.5", code: JPY, wantErr: ErrTooManyDecimalPlaces},
+		{name: "overflow", amount: "92233720368547758.08", code: USD, wantErr: ErrOverflow},
+		{name: "underflow", amount: "-92233720368547758.09", code: USD, wantErr: ErrUnderflow},
+		{name: "empty", amount: "", code: USD, wantErr: ErrInvalidAmount},
+		{name: "sign only", amount: "-", code: USD, wantErr: ErrInvalidAmount},
+		{name: "missing integer part", amount: ".50", code: USD, wantErr: ErrInvalidAmount},
+		{name: "missing fraction", amount: "10.", code: USD, wantErr: ErrInvalidAmount},
+		{name: "exponent", amount: "1e3", code: USD, wantErr: ErrInvalidAmount},
+		{name: "thousand separator", amount: "1,000.00", code: USD, wantErr: ErrInvalidAmount},
+		{name: "whitespace", amount: " 10.00", code: USD, wantErr: ErrInvalidAmount},
+		{name: "two dots", amount: "1.0.0", code: USD, wantErr: ErrInvalidAmount},
+		{name: "invalid currency", amount: "1.00", code: "INVALID", wantErr: ErrCurrencyCodeDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewFromString(tt.amount, tt.code)
+			if err != tt.wantErr {
+				t.Fatalf("NewFromString(%q) error = %v, want %v", tt.amount, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.amount != tt.wantAmount {
+				t.Errorf("NewFromString(%q) amount = %d, want %d", tt.amount, got.amount, tt.wantAmount)
+			}
+			if got.Currency() != tt.code {
+				t.Errorf("NewFromString(%q) currency = %s, want %s", tt.amount, got.Currency(), tt.code)
+			}
+		})
+	}
+}
+
+func TestNewFromMinorUnits(t *testing.T) {
+	t.Parallel()
+
+	got, err := NewFromMinorUnits(1050, USD)
+	if err != nil {
+		t.Fatalf("NewFromMinorUnits() unexpected error: %v", err)
+	}
+	if got.amount != 1050 || got.Currency() != USD {
+		t.Errorf("NewFromMinorUnits() = %d %s, want 1050 USD", got.amount, got.Currency())
+	}
+
+	if _, err := NewFromMinorUnits(1050, "INVALID"); err != ErrCurrencyCodeDoesNotExist {
+		t.Errorf("NewFromMinorUnits() error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+}
+
+func TestNewFromMajorMinor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		major      int64
+		minor      int64
+		code       string
+		wantAmount int64
+		wantErr    error
+	}{
+		{name: "USD", major: 10, minor: 50, code: USD, wantAmount: 1050},
+		{name: "negative USD", major: -10, minor: -50, code: USD, wantAmount: -1050},
+		{name: "negative cents only", major: 0, minor: -50, code: USD, wantAmount: -50},
+		{name: "BHD", major: 1, minor: 5, code: BHD, wantAmount: 1005},
+		{name: "JPY", major: 1500, minor: 0, code: JPY, wantAmount: 1500},
+		{name: "minor too large", major: 10, minor: 100, code: USD, wantErr: ErrInvalidAmount},
+		{name: "JPY with minor", major: 10, minor: 1, code: JPY, wantErr: ErrInvalidAmount},
+		{name: "mixed signs", major: -10, minor: 50, code: USD, wantErr: ErrInvalidAmount},
+		{name: "overflow", major: math.MaxInt64 / 10, minor: 0, code: USD, wantErr: ErrOverflow},
+		{name: "underflow", major: math.MinInt64 / 10, minor: 0, code: USD, wantErr: ErrUnderflow},
+		{name: "invalid currency", major: 1, minor: 0, code: "INVALID", wantErr: ErrCurrencyCodeDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewFromMajorMinor(tt.major, tt.minor, tt.code)
+			if err != tt.wantErr {
+				t.Fatalf("NewFromMajorMinor() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.amount != tt.wantAmount {
+				t.Errorf("NewFromMajorMinor() amount = %d, want %d", got.amount, tt.wantAmount)
+			}
+			if got.MajorUnit() != tt.major || got.MinorUnit() != tt.minor {
+				t.Errorf("NewFromMajorMinor() round trip = %d/%d, want %d/%d", got.MajorUnit(), got.MinorUnit(), tt.major, tt.minor)
+			}
+		})
+	}
+}
+
+func TestNewFromRat(t *testing.T) {
+	t.Parallel()
+
+	huge, _ := new(big.Rat).SetString("92233720368547758.08")
+
+	tests := []struct {
+		name       string
+		amount     *big.Rat
+		code       string
+		wantAmount int64
+		wantErr    error
+	}{
+		{name: "USD", amount: big.NewRat(21, 2), code: USD, wantAmount: 1050},
+		{name: "negative USD", amount: big.NewRat(-1, 4), code: USD, wantAmount: -25},
+		{name: "BHD", amount: big.NewRat(1, 8), code: BHD, wantAmount: 125},
+		{name: "one third", amount: big.NewRat(1, 3), code: USD, wantErr: ErrTooManyDecimalPlaces},
+		{name: "JPY fraction", amount: big.NewRat(1, 2), code: JPY, wantErr: ErrTooManyDecimalPlaces},
+		{name: "overflow", amount: huge, code: USD, wantErr: ErrOverflow},
+		{name: "underflow", amount: new(big.Rat).Neg(huge).Sub(new(big.Rat).Neg(huge), big.NewRat(1, 100)), code: USD, wantErr: ErrUnderflow},
+		{name: "nil", amount: nil, code: USD, wantErr: ErrInvalidAmount},
+		{name: "invalid currency", amount: big.NewRat(1, 1), code: "INVALID", wantErr: ErrCurrencyCodeDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewFromRat(tt.amount, tt.code)
+			if err != tt.wantErr {
+				t.Fatalf("NewFromRat() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.amount != tt.wantAmount {
+				t.Errorf("NewFromRat() amount = %d, want %d", got.amount, tt.wantAmount)
+			}
+		})
+	}
+}
+
+// floatSum adds at run time so the compiler can't fold the constants exactly.
+func floatSum(a, b float64) float64 {
+	return a + b
+}
+
+func TestExactAccessors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		units       int64
+		code        string
+		wantDecimal string
+		wantRat     *big.Rat
+	}{
+		{name: "USD", units: 10050, code: USD, wantDecimal: "100.50", wantRat: big.NewRat(201, 2)},
+		{name: "negative USD", units: -10050, code: USD, wantDecimal: "-100.50", wantRat: big.NewRat(-201, 2)},
+		{name: "USD cents only", units: 5, code: USD, wantDecimal: "0.05", wantRat: big.NewRat(1, 20)},
+		{name: "negative USD cents only", units: -5, code: USD, wantDecimal: "-0.05", wantRat: big.NewRat(-1, 20)},
+		{name: "zero USD", units: 0, code: USD, wantDecimal: "0.00", wantRat: new(big.Rat)},
+		{name: "JPY", units: 1500, code: JPY, wantDecimal: "1500", wantRat: big.NewRat(1500, 1)},
+		{name: "negative JPY", units: -1500, code: JPY, wantDecimal: "-1500", wantRat: big.NewRat(-1500, 1)},
+		{name: "BHD", units: 1005, code: BHD, wantDecimal: "1.005", wantRat: big.NewRat(201, 200)},
+		{name: "negative BHD", units: -1, code: BHD, wantDecimal: "-0.001", wantRat: big.NewRat(-1, 1000)},
+		{name: "CLF", units: 12345, code: CLF, wantDecimal: "1.2345", wantRat: big.NewRat(2469, 2000)},
+		{name: "max int64", units: math.MaxInt64, code: USD, wantDecimal: "92233720368547758.07", wantRat: new(big.Rat).SetFrac64(math.MaxInt64, 100)},
+		{name: "min int64", units: math.MinInt64, code: USD, wantDecimal: "-92233720368547758.08", wantRat: new(big.Rat).SetFrac64(math.MinInt64, 100)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewFromMinorUnits(tt.units, tt.code)
+			if err != nil {
+				t.Fatalf("NewFromMinorUnits() unexpected error: %v", err)
+			}
+
+			if got := m.MinorUnits(); got != tt.units {
+				t.Errorf("MinorUnits() = %d, want %d", got, tt.units)
+			}
+			if got := m.DecimalString(); got != tt.wantDecimal {
+				t.Errorf("DecimalString() = %q, want %q", got, tt.wantDecimal)
+			}
+			if got := string(m.AppendDecimal([]byte("x="))); got != "x="+tt.wantDecimal {
+				t.Errorf("AppendDecimal() = %q, want %q", got, "x="+tt.wantDecimal)
+			}
+			if got := m.Rat(); got.Cmp(tt.wantRat) != 0 {
+				t.Errorf("Rat() = %s, want %s", got, tt.wantRat)
+			}
+			if got := m.String(); got != tt.wantDecimal+" "+tt.code {
+				t.Errorf("String() = %q, want %q", got, tt.wantDecimal+" "+tt.code)
+			}
+
+			// round trip through the exact constructors
+			fromString, err := NewFromString(m.DecimalString(), tt.code)
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error: %v", err)
+			}
+			if fromString.amount != tt.units {
+				t.Errorf("NewFromString(DecimalString()) = %d, want %d", fromString.amount, tt.units)
+			}
+			fromRat, err := NewFromRat(m.Rat(), tt.code)
+			if err != nil {
+				t.Fatalf("NewFromRat() unexpected error: %v", err)
+			}
+			if fromRat.amount != tt.units {
+				t.Errorf("NewFromRat(Rat()) = %d, want %d", fromRat.amount, tt.units)
+			}
+		})
+	}
+}
+
+func TestExactAccessorsNilCurrency(t *testing.T) {
+	t.Parallel()
+
+	m := Money{amount: 1050}
+	if got := m.DecimalString(); got != "1050" {
+		t.Errorf("DecimalString() = %q, want %q", got, "1050")
+	}
+	if got := m.Rat(); got.Cmp(big.NewRat(1050, 1)) != 0 {
+		t.Errorf("Rat() = %s, want 1050", got)
+	}
+}
+
+func TestRoundIsExact(t *testing.T) {
+	t.Parallel()
+
+	// above 2^53 cents float64 can't hold every amount
+	m := mustMinorUnits(9007199254740993, USD)
+	scheme := RoundHalfUp
+	if got := m.Round(&scheme).MinorUnits(); got != 9007199254741000 {
+		t.Errorf("Round() = %d, want 9007199254741000", got)
+	}
+
+	// amounts that can't be rounded away from zero are truncated
+	scheme = RoundCeiling
+	if got := mustMinorUnits(math.MaxInt64, USD).Round(&scheme).MinorUnits(); got != math.MaxInt64-7 {
+		t.Errorf("Round() = %d, want %d", got, int64(math.MaxInt64-7))
+	}
+	if got := mustMinorUnits(math.MaxInt64, USD).Rounded(RoundCeiling).MinorUnits(); got != math.MaxInt64-7 {
+		t.Errorf("Rounded() = %d, want %d", got, int64(math.MaxInt64-7))
+	}
+	// RoundTo and RoundToIncrement report it instead
+	if _, err := mustMinorUnits(math.MaxInt64, USD).RoundTo(0, RoundCeiling); !errors.Is(err, ErrOverflow) {
+		t.Errorf("RoundTo() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := mustMinorUnits(math.MaxInt64, USD).RoundToIncrement(100, RoundCeiling); !errors.Is(err, ErrOverflow) {
+		t.Errorf("RoundToIncrement() error = %v, want %v", err, ErrOverflow)
+	}
+
+	// negative amounts round to the nearest unit, ties as before
+	for _, tt := range []struct {
+		amount int64
+		scheme RoundScheme
+		want   int64
+	}{
+		{amount: -270, scheme: RoundHalfUp, want: -300},
+		{amount: -230, scheme: RoundHalfDown, want: -200},
+		{amount: -250, scheme: RoundHalfUp, want: -200},
+		{amount: -250, scheme: RoundHalfDown, want: -300},
+	} {
+		if got := mustMinorUnits(tt.amount, USD).Rounded(tt.scheme).MinorUnits(); got != tt.want {
+			t.Errorf("Rounded(%v) of %d = %d, want %d", tt.scheme, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestRoundTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		decimals int
+		scheme   RoundScheme
+		want     string
+		wantErr  error
+	}{
+		{name: "to cents", amount: "1234.567", currency: KWD, decimals: 2, scheme: RoundHalfEven, want: "1234.570 KWD"},
+		{name: "tie to even", amount: "1234.565", currency: KWD, decimals: 2, scheme: RoundHalfEven, want: "1234.560 KWD"},
+		{name: "to whole units", amount: "-2.50", currency: USD, decimals: 0, scheme: RoundHalfDown, want: "-3.00 USD"},
+		{name: "to hundreds", amount: "1250.00", currency: USD, decimals: -2, scheme: RoundHalfUp, want: "1300.00 USD"},
+		{name: "to thousands floor", amount: "-1.00", currency: USD, decimals: -3, scheme: RoundFloor, want: "-1000.00 USD"},
+		{name: "zero decimal currency", amount: "1499", currency: JPY, decimals: -3, scheme: RoundHalfUp, want: "1000 JPY"},
+		{name: "more decimals than the currency", amount: "12.34", currency: USD, decimals: 4, scheme: RoundCeiling, want: "12.34 USD"},
+		{name: "increment beyond int64 rounds to zero", amount: "12.34", currency: USD, decimals: -20, scheme: RoundHalfUp, want: "0.00 USD"},
+		{name: "increment beyond int64 overflows", amount: "12.34", currency: USD, decimals: -20, scheme: RoundCeiling, wantErr: ErrOverflow},
+		{name: "increment beyond int64 underflows", amount: "-12.34", currency: USD, decimals: -20, scheme: RoundFloor, wantErr: ErrUnderflow},
+		{name: "largest increment overflows", amount: "92233720368547758.07", currency: USD, decimals: -16, scheme: RoundCeiling, wantErr: ErrOverflow},
+		{name: "overflow", amount: "92233720368547758.07", currency: USD, decimals: 0, scheme: RoundCeiling, wantErr: ErrOverflow},
+		{name: "overflow half up", amount: "92233720368547758.07", currency: USD, decimals: -1, scheme: RoundHalfUp, wantErr: ErrOverflow},
+		{name: "underflow", amount: "-92233720368547758.08", currency: USD, decimals: 0, scheme: RoundFloor, wantErr: ErrUnderflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewFromString(tt.amount, tt.currency)
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error: %v", err)
+			}
+			got, err := m.RoundTo(tt.decimals, tt.scheme)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RoundTo() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("RoundTo(%d, %v) = %s, want %s", tt.decimals, tt.scheme, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		amount    string
+		currency  string
+		increment int64
+		scheme    RoundScheme
+		want      string
+		wantErr   error
+	}{
+		{name: "nearest 0.05", amount: "12.43", currency: CHF, increment: 5, scheme: RoundHalfUp, want: "12.45 CHF"},
+		{name: "nearest 0.05 down", amount: "12.42", currency: CHF, increment: 5, scheme: RoundHalfUp, want: "12.40 CHF"},
+		{name: "nearest 0.05 tie to even", amount: "12.475", currency: KWD, increment: 50, scheme: RoundHalfEven, want: "12.500 KWD"},
+		{name: "nearest 10", amount: "-15.00", currency: USD, increment: 1000, scheme: RoundHalfUp, want: "-10.00 USD"},
+		{name: "nearest 1000", amount: "1500", currency: JPY, increment: 1000, scheme: RoundHalfEven, want: "2000 JPY"},
+		{name: "nearest 0.25 away from zero", amount: "-0.01", currency: USD, increment: 25, scheme: RoundAwayFromZero, want: "-0.25 USD"},
+		{name: "zero increment", amount: "1.00", currency: USD, increment: 0, scheme: RoundHalfUp, wantErr: ErrInvalidAmount},
+		{name: "negative increment", amount: "1.00", currency: USD, increment: -5, scheme: RoundHalfUp, wantErr: ErrInvalidAmount},
+		{name: "overflow", amount: "92233720368547758.07", currency: USD, increment: 10, scheme: RoundCeiling, wantErr: ErrOverflow},
+		{name: "overflow away from zero", amount: "92233720368547758.07", currency: USD, increment: 100, scheme: RoundAwayFromZero, wantErr: ErrOverflow},
+		{name: "underflow", amount: "-92233720368547758.08", currency: USD, increment: 5, scheme: RoundFloor, wantErr: ErrUnderflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewFromString(tt.amount, tt.currency)
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error: %v", err)
+			}
+			got, err := m.RoundToIncrement(tt.increment, tt.scheme)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RoundToIncrement() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("RoundToIncrement(%d, %v) = %s, want %s", tt.increment, tt.scheme, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuoRem(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		amount  int64
+		divisor int64
+		wantQuo int64
+		wantRem int64
+		wantErr error
+	}{
+		{name: "split a fee in three", amount: 10000, divisor: 3, wantQuo: 3333, wantRem: 1},
+		{name: "exact", amount: 900, divisor: 3, wantQuo: 300, wantRem: 0},
+		{name: "negative amount", amount: -10000, divisor: 3, wantQuo: -3333, wantRem: -1},
+		{name: "negative divisor", amount: 10000, divisor: -3, wantQuo: -3333, wantRem: 1},
+		{name: "minimum by one", amount: math.MinInt64, divisor: 1, wantQuo: math.MinInt64, wantRem: 0},
+		{name: "minimum by minus one", amount: math.MinInt64, divisor: -1, wantErr: ErrOverflow},
+		{name: "zero divisor", amount: 100, divisor: 0, wantErr: ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mustMinorUnits(tt.amount, USD)
+			quo, rem, err := m.QuoRem(tt.divisor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("QuoRem() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if quo.MinorUnits() != tt.wantQuo || rem.MinorUnits() != tt.wantRem {
+				t.Errorf("QuoRem(%d) = %d, %d, want %d, %d", tt.divisor, quo.MinorUnits(), rem.MinorUnits(), tt.wantQuo, tt.wantRem)
+			}
+			if quo.Currency() != USD || rem.Currency() != USD {
+				t.Errorf("QuoRem() currencies = %s, %s, want USD", quo.Currency(), rem.Currency())
+			}
+		})
+	}
+}
+
+func TestDivideRound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		amount  int64
+		divisor int64
+		scheme  RoundScheme
+		want    int64
+		wantErr error
+	}{
+		{name: "half even tie down", amount: 5, divisor: 2, scheme: RoundHalfEven, want: 2},
+		{name: "half even tie up", amount: 7, divisor: 2, scheme: RoundHalfEven, want: 4},
+		{name: "half up", amount: 10000, divisor: 3, scheme: RoundHalfUp, want: 3333},
+		{name: "half up negative tie", amount: -5, divisor: 2, scheme: RoundHalfUp, want: -2},
+		{name: "half down negative tie", amount: 5, divisor: -2, scheme: RoundHalfDown, want: -3},
+		{name: "ceiling", amount: 10000, divisor: 3, scheme: RoundCeiling, want: 3334},
+		{name: "floor negative", amount: -10000, divisor: 3, scheme: RoundFloor, want: -3334},
+		{name: "away from zero", amount: 1, divisor: 1000, scheme: RoundAwayFromZero, want: 1},
+		{name: "toward zero", amount: -1999, divisor: 1000, scheme: RoundTowardZero, want: -1},
+		{name: "minimum by minus one", amount: math.MinInt64, divisor: -1, scheme: RoundHalfUp, wantErr: ErrOverflow},
+		{name: "zero divisor", amount: 1, divisor: 0, scheme: RoundHalfUp, wantErr: ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mustMinorUnits(tt.amount, USD).DivideRound(tt.divisor, tt.scheme)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DivideRound() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.MinorUnits() != tt.want {
+				t.Errorf("DivideRound(%d, %v) = %d, want %d", tt.divisor, tt.scheme, got.MinorUnits(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDivideRat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		amount  int64
+		num     int64
+		den     int64
+		scheme  RoundScheme
+		want    int64
+		wantErr error
+	}{
+		{name: "remove 20% VAT", amount: 10000, num: 6, den: 5, scheme: RoundHalfUp, want: 8333},
+		{name: "divide by a fraction below one", amount: 1000, num: 1, den: 3, scheme: RoundHalfUp, want: 3000},
+		{name: "negative rational", amount: 1000, num: -3, den: 2, scheme: RoundHalfEven, want: -667},
+		{name: "large intermediate product", amount: math.MaxInt64, num: 3, den: 3, scheme: RoundHalfUp, want: math.MaxInt64},
+		{name: "overflow", amount: math.MaxInt64, num: 1, den: 2, scheme: RoundHalfUp, wantErr: ErrOverflow},
+		{name: "underflow", amount: math.MinInt64, num: 2, den: 3, scheme: RoundHalfUp, wantErr: ErrUnderflow},
+		{name: "zero numerator", amount: 1, num: 0, den: 3, scheme: RoundHalfUp, wantErr: ErrDivisionByZero},
+		{name: "zero denominator", amount: 1, num: 3, den: 0, scheme: RoundHalfUp, wantErr: ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mustMinorUnits(tt.amount, USD).DivideRat(tt.num, tt.den, tt.scheme)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DivideRat() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.MinorUnits() != tt.want {
+				t.Errorf("DivideRat(%d/%d, %v) = %d, want %d", tt.num, tt.den, tt.scheme, got.MinorUnits(), tt.want)
+			}
+		})
+	}
+}
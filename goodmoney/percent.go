@@ -0,0 +1,179 @@
+package goodmoney
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Percent is an exact percentage such as 7.25% or 725 basis points, kept as a
+// fraction of one. The zero Percent is 0%.
+type Percent struct {
+	num int64
+	den int64
+}
+
+// BasisPoints is a percentage in hundredths of a percent: 725 basis points are 7.25%.
+type BasisPoints int64
+
+// Percent returns b as a Percent.
+func (b BasisPoints) Percent() Percent {
+	p, _ := percentOf(new(big.Rat).SetFrac64(int64(b), 10000))
+	return p
+}
+
+// String returns b as "725bp".
+func (b BasisPoints) String() string {
+	return strconv.FormatInt(int64(b), 10) + "bp"
+}
+
+// ParsePercent parses a percentage ("7.25%", "-5%"), a number of basis points
+// ("725bp" or "725bps") or a bare number of percent ("7.25"). Fractions are accepted
+// too ("100/3%"). Returns ErrInvalidAmount for malformed input and ErrPrecisionLoss
+// if the percentage doesn't fit in int64 terms.
+//
+// Example:
+//
+//	vat, _ := ParsePercent("7.5%")
+//	fee, _ := ParsePercent("29bp")
+func ParsePercent(s string) (Percent, error) {
+	value := strings.TrimSpace(s)
+	scale := int64(100)
+	switch {
+	case strings.HasSuffix(value, "bps"):
+		value, scale = strings.TrimSuffix(value, "bps"), 10000
+	case strings.HasSuffix(value, "bp"):
+		value, scale = strings.TrimSuffix(value, "bp"), 10000
+	default:
+		value = strings.TrimSuffix(value, "%")
+	}
+
+	f, err := ParseFactor(strings.TrimSpace(value))
+	if err != nil {
+		return Percent{}, fmt.Errorf("invalid percentage %q: %w", s, err)
+	}
+	return percentOf(new(big.Rat).Quo(f.Rat(), new(big.Rat).SetInt64(scale)))
+}
+
+// NewPercent returns the percentage num/den percent: NewPercent(15, 1) is 15%,
+// NewPercent(100, 3) is a third. Returns ErrDivisionByZero if den is zero.
+func NewPercent(num, den int64) (Percent, error) {
+	if den == 0 {
+		return Percent{}, ErrDivisionByZero
+	}
+	return percentOf(new(big.Rat).SetFrac(big.NewInt(num), new(big.Int).Mul(big.NewInt(den), big.NewInt(100))))
+}
+
+// percentOf returns the Percent of the fraction of one r.
+func percentOf(r *big.Rat) (Percent, error) {
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return Percent{}, ErrPrecisionLoss
+	}
+	return Percent{num: r.Num().Int64(), den: r.Denom().Int64()}, nil
+}
+
+// Rat returns p as a fraction of one: 7.25% is 29/400.
+func (p Percent) Rat() *big.Rat {
+	if p.den == 0 {
+		return new(big.Rat)
+	}
+	return big.NewRat(p.num, p.den)
+}
+
+// Factor returns p as a fraction of one, to scale Money with MulRate.
+func (p Percent) Factor() Factor {
+	if p.den == 0 {
+		return Factor{num: 0, den: 1}
+	}
+	return Factor{num: p.num, den: p.den}
+}
+
+// String returns p in percent, exactly: "7.25%", or "100/3%" when it doesn't terminate.
+func (p Percent) String() string {
+	return ratString(new(big.Rat).Mul(p.Rat(), big.NewRat(100, 1))) + "%"
+}
+
+// BasisPoints returns p in basis points, and false if it isn't a whole number of them.
+func (p Percent) BasisPoints() (BasisPoints, bool) {
+	bp := new(big.Rat).Mul(p.Rat(), big.NewRat(10000, 1))
+	if !bp.IsInt() || !bp.Num().IsInt64() {
+		return 0, false
+	}
+	return BasisPoints(bp.Num().Int64()), true
+}
+
+// scaleByPercent returns m·(1+p), or m·(1-p) when subtract is set, rounded with scheme.
+func (m Money) scaleByPercent(p Percent, subtract bool, scheme RoundScheme) (*Money, error) {
+	f := p.Factor()
+	op := addInt64
+	if subtract {
+		op = subtractInt64
+	}
+	num, err := op(f.den, f.num)
+	if err != nil {
+		return nil, err
+	}
+	return m.MulRate(Factor{num: num, den: f.den}, scheme)
+}
+
+// PercentOf returns p of m rounded to the minor unit with the given scheme.
+//
+// Example:
+//
+//	tip, _ := bill.PercentOf(fifteenPercent, RoundHalfUp)
+func (m Money) PercentOf(p Percent, scheme RoundScheme) (*Money, error) {
+	return m.MulRate(p.Factor(), scheme)
+}
+
+// AddPercent returns m increased by p, m·(1+p), rounded once with the given scheme.
+//
+// Example:
+//
+//	vat, _ := ParsePercent("7.5%")
+//	gross, _ := net.AddPercent(vat, RoundHalfUp)
+func (m Money) AddPercent(p Percent, scheme RoundScheme) (*Money, error) {
+	return m.scaleByPercent(p, false, scheme)
+}
+
+// SubtractPercent returns m decreased by p, m·(1-p), rounded once with the given scheme.
+func (m Money) SubtractPercent(p Percent, scheme RoundScheme) (*Money, error) {
+	return m.scaleByPercent(p, true, scheme)
+}
+
+// Markup returns the price of a cost m with a markup p on cost, m·(1+p), rounded
+// with the given scheme: a 25% markup on 80.00 is 100.00.
+func (m Money) Markup(p Percent, scheme RoundScheme) (*Money, error) {
+	return m.AddPercent(p, scheme)
+}
+
+// Margin returns the price of a cost m that leaves a margin p of the price,
+// m/(1-p), rounded with the given scheme: a 20% margin on 80.00 is 100.00.
+// Returns ErrInvalidAmount if p is 100% or more.
+func (m Money) Margin(p Percent, scheme RoundScheme) (*Money, error) {
+	f := p.Factor()
+	if f.num >= f.den {
+		return nil, fmt.Errorf("%w: margin of %s", ErrInvalidAmount, p)
+	}
+	// m / (1-p) = m · den / (den - num)
+	remaining, err := subtractInt64(f.den, f.num)
+	if err != nil {
+		return nil, err
+	}
+	return m.MulRate(Factor{num: f.den, den: remaining}, scheme)
+}
+
+// PercentChange returns the exact change from m to other as a Percent of m:
+// 80.00 to 100.00 is 25%.
+// Returns ErrCurrencyMismatch for different currencies, ErrDivisionByZero if m is
+// zero and ErrPrecisionLoss if the change doesn't fit in int64 terms.
+func (m Money) PercentChange(other *Money) (Percent, error) {
+	if other == nil || !m.currency.sameAs(other.currency) {
+		return Percent{}, ErrCurrencyMismatch
+	}
+	if m.amount == 0 {
+		return Percent{}, ErrDivisionByZero
+	}
+	change := new(big.Int).Sub(big.NewInt(other.amount), big.NewInt(m.amount))
+	return percentOf(new(big.Rat).SetFrac(change, big.NewInt(m.amount)))
+}
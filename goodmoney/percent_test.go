@@ -0,0 +1,160 @@
+package goodmoney
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePercent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    string
+		wantBP  BasisPoints
+		wholeBP bool
+		wantErr error
+	}{
+		{input: "7.25%", want: "7.25%", wantBP: 725, wholeBP: true},
+		{input: "725bp", want: "7.25%", wantBP: 725, wholeBP: true},
+		{input: "725bps", want: "7.25%", wantBP: 725, wholeBP: true},
+		{input: "7.25", want: "7.25%", wantBP: 725, wholeBP: true},
+		{input: " -5 % ", want: "-5%", wantBP: -500, wholeBP: true},
+		{input: "0.125%", want: "0.125%"},
+		{input: "100/3%", want: "100/3%"},
+		{input: "0%", want: "0%", wholeBP: true},
+		{input: "abc%", wantErr: ErrInvalidAmount},
+		{input: "%", wantErr: ErrInvalidAmount},
+		{input: "1/0%", wantErr: ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePercent(tt.input)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParsePercent(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParsePercent(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+		if bp, ok := got.BasisPoints(); ok != tt.wholeBP || bp != tt.wantBP {
+			t.Errorf("ParsePercent(%q).BasisPoints() = %v, %v, want %v, %v", tt.input, bp, ok, tt.wantBP, tt.wholeBP)
+		}
+	}
+}
+
+func TestNewPercent(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewPercent(15, 1)
+	if err != nil || p.String() != "15%" || p.Rat().RatString() != "3/20" {
+		t.Errorf("NewPercent(15, 1) = %s (%s), %v, want 15%% (3/20)", p, p.Rat().RatString(), err)
+	}
+	if p, _ := NewPercent(100, 3); p.Rat().RatString() != "1/3" {
+		t.Errorf("NewPercent(100, 3) = %s, want 1/3", p.Rat().RatString())
+	}
+	if _, err := NewPercent(1, 0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("NewPercent(1, 0) error = %v, want %v", err, ErrDivisionByZero)
+	}
+
+	if got := BasisPoints(29).Percent().String(); got != "0.29%" {
+		t.Errorf("BasisPoints(29).Percent() = %s, want 0.29%%", got)
+	}
+	if got := BasisPoints(725).String(); got != "725bp" {
+		t.Errorf("BasisPoints(725).String() = %s, want 725bp", got)
+	}
+	if got := (Percent{}).String(); got != "0%" {
+		t.Errorf("zero Percent = %s, want 0%%", got)
+	}
+}
+
+func TestPercentOperations(t *testing.T) {
+	t.Parallel()
+
+	percent := func(s string) Percent {
+		p, err := ParsePercent(s)
+		if err != nil {
+			t.Fatalf("ParsePercent(%q) unexpected error: %v", s, err)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name    string
+		op      func(m Money, p Percent, scheme RoundScheme) (*Money, error)
+		amount  string
+		percent Percent
+		scheme  RoundScheme
+		want    string
+		wantErr error
+	}{
+		{name: "15% of", op: Money.PercentOf, amount: "100.00", percent: percent("15%"), scheme: RoundHalfUp, want: "15.00"},
+		{name: "percent of rounds once", op: Money.PercentOf, amount: "19.99", percent: percent("2.9%"), scheme: RoundHalfUp, want: "0.58"},
+		{name: "percent of half even", op: Money.PercentOf, amount: "0.50", percent: percent("5%"), scheme: RoundHalfEven, want: "0.02"},
+		{name: "basis points of", op: Money.PercentOf, amount: "1000.00", percent: BasisPoints(29).Percent(), scheme: RoundHalfUp, want: "2.90"},
+		{name: "add 7.5% VAT", op: Money.AddPercent, amount: "19.99", percent: percent("7.5%"), scheme: RoundHalfUp, want: "21.49"},
+		{name: "subtract 10%", op: Money.SubtractPercent, amount: "19.99", percent: percent("10%"), scheme: RoundHalfUp, want: "17.99"},
+		{name: "subtract rounds the result", op: Money.SubtractPercent, amount: "19.99", percent: percent("10%"), scheme: RoundCeiling, want: "18.00"},
+		{name: "markup on cost", op: Money.Markup, amount: "80.00", percent: percent("25%"), scheme: RoundHalfUp, want: "100.00"},
+		{name: "margin on price", op: Money.Margin, amount: "80.00", percent: percent("20%"), scheme: RoundHalfUp, want: "100.00"},
+		{name: "margin rounds", op: Money.Margin, amount: "10.00", percent: percent("100/3%"), scheme: RoundHalfUp, want: "15.00"},
+		{name: "margin of 30%", op: Money.Margin, amount: "10.00", percent: percent("30%"), scheme: RoundHalfUp, want: "14.29"},
+		{name: "margin of 100%", op: Money.Margin, amount: "10.00", percent: percent("100%"), scheme: RoundHalfUp, wantErr: ErrInvalidAmount},
+		{name: "negative percent", op: Money.AddPercent, amount: "10.00", percent: percent("-5%"), scheme: RoundHalfUp, want: "9.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := MakeFromString(tt.amount, USD)
+			if err != nil {
+				t.Fatalf("MakeFromString() unexpected error: %v", err)
+			}
+			got, err := tt.op(m, tt.percent, tt.scheme)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.DecimalString() != tt.want {
+				t.Errorf("%s %s = %s, want %s", tt.percent, tt.amount, got.DecimalString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		from    *Money
+		to      *Money
+		want    string
+		wantErr error
+	}{
+		{name: "increase", from: mustMinorUnits(8000, USD), to: mustMinorUnits(10000, USD), want: "25%"},
+		{name: "decrease", from: mustMinorUnits(10000, USD), to: mustMinorUnits(8000, USD), want: "-20%"},
+		{name: "repeating", from: mustMinorUnits(300, USD), to: mustMinorUnits(400, USD), want: "100/3%"},
+		{name: "no change", from: mustMinorUnits(300, USD), to: mustMinorUnits(300, USD), want: "0%"},
+		{name: "from zero", from: mustMinorUnits(0, USD), to: mustMinorUnits(300, USD), wantErr: ErrDivisionByZero},
+		{name: "different currencies", from: mustMinorUnits(300, USD), to: mustMinorUnits(300, EUR), wantErr: ErrCurrencyMismatch},
+		{name: "nil", from: mustMinorUnits(300, USD), wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.from.PercentChange(tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PercentChange() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("PercentChange() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
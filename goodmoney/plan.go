@@ -0,0 +1,210 @@
+package goodmoney
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrReversalExceedsAllocation happens when a reversal is larger than what remains
+// of an AllocationPlan.
+var ErrReversalExceedsAllocation = errors.New("reversal exceeds the remaining allocation")
+
+// AllocationPlan records how an amount was split so that later partial refunds and
+// chargebacks are split the same way. Each reversal is split in proportion to what
+// remains of every part, so a party never gets back more than its original part,
+// rounding in one reversal is made up by the next and reversing the whole remainder
+// returns every part exactly.
+//
+// An AllocationPlan is not safe for concurrent use. Reversals either apply completely
+// or, on error, not at all.
+//
+// Example:
+//
+//	parts, _ := order.Allocate(80, 15, 5)
+//	plan, _ := NewAllocationPlan(parts...)
+//	refund, err := plan.Reverse(partialRefund) // seller, tax and fee shares of the refund
+type AllocationPlan struct {
+	currency *currencyEntry
+	parts    []int64
+	reversed []int64
+}
+
+// NewAllocationPlan returns a plan for the parts of a previous allocation, such as
+// the result of Allocate, with nothing reversed yet.
+// Returns ErrCurrencyMismatch if a part is nil or the parts have different
+// currencies, and an error for negative parts or sums that overflow.
+func NewAllocationPlan(parts ...*Money) (*AllocationPlan, error) {
+	return newAllocationPlan(parts, nil)
+}
+
+// Plan returns a plan for the parts of a.
+func (a Allocation) Plan() (*AllocationPlan, error) {
+	return NewAllocationPlan(a.Parts...)
+}
+
+// newAllocationPlan validates parts and the amounts already reversed from them, nil
+// for none.
+func newAllocationPlan(parts, reversed []*Money) (*AllocationPlan, error) {
+	if len(parts) == 0 {
+		return nil, errors.New("no parts specified")
+	}
+	if parts[0] == nil || parts[0].currency == nil {
+		return nil, ErrCurrencyMismatch
+	}
+	if reversed != nil && len(reversed) != len(parts) {
+		return nil, errors.New("reversed amounts don't match the parts")
+	}
+
+	p := &AllocationPlan{
+		currency: parts[0].currency,
+		parts:    make([]int64, len(parts)),
+		reversed: make([]int64, len(parts)),
+	}
+	var total int64
+	for i, part := range parts {
+		if part == nil || !part.currency.sameAs(p.currency) {
+			return nil, ErrCurrencyMismatch
+		}
+		if part.amount < 0 {
+			return nil, errors.New("negative parts not allowed")
+		}
+		var err error
+		if total, err = addInt64(total, part.amount); err != nil {
+			return nil, err
+		}
+		p.parts[i] = part.amount
+
+		if reversed == nil {
+			continue
+		}
+		if reversed[i] == nil || !reversed[i].currency.sameAs(p.currency) {
+			return nil, ErrCurrencyMismatch
+		}
+		if reversed[i].amount < 0 || reversed[i].amount > part.amount {
+			return nil, fmt.Errorf("%w: party %d", ErrReversalExceedsAllocation, i)
+		}
+		p.reversed[i] = reversed[i].amount
+	}
+	return p, nil
+}
+
+// Reverse splits amount across the parties in proportion to what remains of their
+// parts, records it and returns the share of each party. The leftover minor units go
+// to the largest remainders.
+// Returns ErrCurrencyMismatch if amount is nil or in another currency,
+// ErrReversalExceedsAllocation if it's more than remains, and an error if it's negative.
+//
+// Example:
+//
+//	shares, err := plan.Reverse(refund)
+//	if errors.Is(err, ErrReversalExceedsAllocation) {
+//	    // refunded more than was paid
+//	}
+func (p *AllocationPlan) Reverse(amount *Money) ([]*Money, error) {
+	if amount == nil || !amount.currency.sameAs(p.currency) {
+		return nil, ErrCurrencyMismatch
+	}
+	if amount.amount < 0 {
+		return nil, errors.New("negative reversals not allowed")
+	}
+
+	remaining := make([]*big.Int, len(p.parts))
+	var total int64
+	for i := range p.parts {
+		remaining[i] = big.NewInt(p.parts[i] - p.reversed[i])
+		total += p.parts[i] - p.reversed[i]
+	}
+	if amount.amount > total {
+		return nil, ErrReversalExceedsAllocation
+	}
+
+	// every share is at most its remainder: the exact share is, and only fractional
+	// shares are rounded up
+	a, err := amount.allocate(LargestRemainder{}, remaining)
+	if err != nil {
+		return nil, err
+	}
+	for i, part := range a.Parts {
+		p.reversed[i] += part.amount
+	}
+	return a.Parts, nil
+}
+
+// Parts returns the original parts.
+func (p *AllocationPlan) Parts() []*Money {
+	return p.moneys(p.parts)
+}
+
+// Reversed returns the amount reversed so far from each part.
+func (p *AllocationPlan) Reversed() []*Money {
+	return p.moneys(p.reversed)
+}
+
+// Remaining returns what remains of each part after the reversals so far.
+func (p *AllocationPlan) Remaining() []*Money {
+	remaining := make([]int64, len(p.parts))
+	for i := range p.parts {
+		remaining[i] = p.parts[i] - p.reversed[i]
+	}
+	return p.moneys(remaining)
+}
+
+// RemainingTotal returns the most that can still be reversed.
+func (p *AllocationPlan) RemainingTotal() *Money {
+	var total int64
+	for i := range p.parts {
+		total += p.parts[i] - p.reversed[i]
+	}
+	return &Money{amount: total, currency: p.currency}
+}
+
+// moneys returns units as Money of the plan's currency.
+func (p *AllocationPlan) moneys(units []int64) []*Money {
+	ms := make([]*Money, len(units))
+	for i, u := range units {
+		ms[i] = &Money{amount: u, currency: p.currency}
+	}
+	return ms
+}
+
+// allocationPlanJSON represents the JSON structure for AllocationPlan serialization.
+type allocationPlanJSON struct {
+	Parts    []*Money `json:"parts"`
+	Reversed []*Money `json:"reversed"`
+}
+
+// MarshalJSON implements json.Marshaler interface, so a plan can be stored with the
+// order and replayed later. It serializes the parts and the amounts reversed so
+// far, each in the format of Money:
+// {"parts": [{"amount": 80, "currency": "USD"}, ...], "reversed": [...]}
+func (p AllocationPlan) MarshalJSON() ([]byte, error) {
+	return json.Marshal(allocationPlanJSON{
+		Parts:    p.Parts(),
+		Reversed: p.Reversed(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (p *AllocationPlan) UnmarshalJSON(data []byte) error {
+	var j allocationPlanJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return fmt.Errorf("failed to unmarshal AllocationPlan: %w", err)
+	}
+	if j.Reversed == nil {
+		j.Reversed = make([]*Money, len(j.Parts))
+		for i, part := range j.Parts {
+			if part != nil {
+				j.Reversed[i] = &Money{currency: part.currency}
+			}
+		}
+	}
+
+	decoded, err := newAllocationPlan(j.Parts, j.Reversed)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal AllocationPlan: %w", err)
+	}
+	*p = *decoded
+	return nil
+}
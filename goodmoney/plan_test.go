@@ -0,0 +1,182 @@
+package goodmoney
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func minorUnitsOf(ms []*Money) []int64 {
+	units := make([]int64, len(ms))
+	for i, m := range ms {
+		units[i] = m.MinorUnits()
+	}
+	return units
+}
+
+func TestAllocationPlanReverse(t *testing.T) {
+	t.Parallel()
+
+	order := mustMinorUnits(10000, USD)
+	parts, err := order.Allocate(80, 15, 5)
+	if err != nil {
+		t.Fatalf("Allocate() unexpected error: %v", err)
+	}
+	plan, err := NewAllocationPlan(parts...)
+	if err != nil {
+		t.Fatalf("NewAllocationPlan() unexpected error: %v", err)
+	}
+
+	// 33.33 in proportion to 80.00, 15.00 and 5.00 is 26.664, 4.9995 and 1.6665
+	refund, err := plan.Reverse(mustMinorUnits(3333, USD))
+	if err != nil {
+		t.Fatalf("Reverse() unexpected error: %v", err)
+	}
+	if got, want := minorUnitsOf(refund), []int64{2666, 500, 167}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse(33.33) = %v, want %v", got, want)
+	}
+	if got, want := minorUnitsOf(plan.Remaining()), []int64{5334, 1000, 333}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Remaining() = %v, want %v", got, want)
+	}
+
+	// a chargeback larger than what remains changes nothing
+	if _, err := plan.Reverse(mustMinorUnits(6668, USD)); !errors.Is(err, ErrReversalExceedsAllocation) {
+		t.Errorf("Reverse(66.68) error = %v, want %v", err, ErrReversalExceedsAllocation)
+	}
+	if got := plan.RemainingTotal(); got.MinorUnits() != 6667 {
+		t.Errorf("RemainingTotal() = %s, want 66.67 USD", got)
+	}
+
+	refund, err = plan.Reverse(plan.RemainingTotal())
+	if err != nil {
+		t.Fatalf("Reverse(rest) unexpected error: %v", err)
+	}
+	if got, want := minorUnitsOf(refund), []int64{5334, 1000, 333}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse(rest) = %v, want %v", got, want)
+	}
+	if got := minorUnitsOf(plan.Reversed()); !reflect.DeepEqual(got, minorUnitsOf(plan.Parts())) {
+		t.Errorf("Reversed() = %v, want the parts %v", got, minorUnitsOf(plan.Parts()))
+	}
+}
+
+func TestAllocationPlanReconciles(t *testing.T) {
+	t.Parallel()
+
+	a, _ := mustMinorUnits(100, USD).AllocateWith(LargestRemainder{}, 1, 1, 1, 0)
+	plan, err := a.Plan()
+	if err != nil {
+		t.Fatalf("Plan() unexpected error: %v", err)
+	}
+
+	// refund a cent at a time: no party ever gets back more than its part
+	for i := 0; i < 100; i++ {
+		shares, err := plan.Reverse(mustMinorUnits(1, USD))
+		if err != nil {
+			t.Fatalf("Reverse() #%d unexpected error: %v", i, err)
+		}
+		var sum int64
+		for _, share := range shares {
+			sum += share.MinorUnits()
+		}
+		if sum != 1 {
+			t.Fatalf("Reverse() #%d shares sum to %d, want 1", i, sum)
+		}
+		for j, reversed := range minorUnitsOf(plan.Reversed()) {
+			if reversed > a.Parts[j].MinorUnits() {
+				t.Fatalf("Reverse() #%d gave party %d back %d, more than its %s", i, j, reversed, a.Parts[j])
+			}
+		}
+	}
+	if got := minorUnitsOf(plan.Reversed()); !reflect.DeepEqual(got, []int64{34, 33, 33, 0}) {
+		t.Errorf("Reversed() = %v, want [34 33 33 0]", got)
+	}
+	if !plan.RemainingTotal().IsZero() {
+		t.Errorf("RemainingTotal() = %s, want zero", plan.RemainingTotal())
+	}
+	if _, err := plan.Reverse(mustMinorUnits(1, USD)); !errors.Is(err, ErrReversalExceedsAllocation) {
+		t.Errorf("Reverse() past the total error = %v, want %v", err, ErrReversalExceedsAllocation)
+	}
+}
+
+func TestAllocationPlanErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewAllocationPlan(); err == nil {
+		t.Error("NewAllocationPlan() without parts succeeded, want an error")
+	}
+	if _, err := NewAllocationPlan(mustMinorUnits(1, USD), mustMinorUnits(1, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("NewAllocationPlan() mixed currencies error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := NewAllocationPlan(mustMinorUnits(1, USD), nil); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("NewAllocationPlan(nil) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := NewAllocationPlan(mustMinorUnits(-1, USD)); err == nil {
+		t.Error("NewAllocationPlan() with a negative part succeeded, want an error")
+	}
+	if _, err := NewAllocationPlan(mustMinorUnits(1<<62, USD), mustMinorUnits(1<<62, USD)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("NewAllocationPlan() overflowing error = %v, want %v", err, ErrOverflow)
+	}
+
+	plan, _ := NewAllocationPlan(mustMinorUnits(100, USD))
+	if _, err := plan.Reverse(mustMinorUnits(1, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Reverse(EUR) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := plan.Reverse(nil); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Reverse(nil) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := plan.Reverse(mustMinorUnits(-1, USD)); err == nil {
+		t.Error("Reverse() of a negative amount succeeded, want an error")
+	}
+}
+
+func TestAllocationPlanJSON(t *testing.T) {
+	t.Parallel()
+
+	plan, _ := NewAllocationPlan(mustMinorUnits(8000, USD), mustMinorUnits(2000, USD))
+	if _, err := plan.Reverse(mustMinorUnits(999, USD)); err != nil {
+		t.Fatalf("Reverse() unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		t.Fatalf("MarshalJSON() unexpected error: %v", err)
+	}
+	want := `{"parts":[{"amount":80,"currency":"USD"},{"amount":20,"currency":"USD"}],"reversed":[{"amount":7.99,"currency":"USD"},{"amount":2,"currency":"USD"}]}`
+	if string(data) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", data, want)
+	}
+
+	// a plan held by value, as in an order record
+	type order struct {
+		Plan AllocationPlan `json:"plan"`
+	}
+	stored, err := json.Marshal(order{Plan: *plan})
+	if err != nil {
+		t.Fatalf("MarshalJSON() unexpected error: %v", err)
+	}
+	if want := `{"plan":` + want + `}`; string(stored) != want {
+		t.Errorf("MarshalJSON() of a struct field = %s, want %s", stored, want)
+	}
+
+	var restored AllocationPlan
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("UnmarshalJSON() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(minorUnitsOf(restored.Remaining()), minorUnitsOf(plan.Remaining())) {
+		t.Errorf("UnmarshalJSON() remaining = %v, want %v", restored.Remaining(), plan.Remaining())
+	}
+
+	if err := json.Unmarshal([]byte(`{"parts":[{"amount":80,"currency":"USD"}]}`), &restored); err != nil || restored.RemainingTotal().MinorUnits() != 8000 {
+		t.Errorf("UnmarshalJSON() without reversals = %v, %v, want 80.00 USD remaining", restored.RemainingTotal(), err)
+	}
+	for _, input := range []string{
+		`{"parts":[{"amount":80,"currency":"USD"}],"reversed":[{"amount":81,"currency":"USD"}]}`,
+		`{"parts":[{"amount":80,"currency":"USD"}],"reversed":[]}`,
+		`{"parts":[]}`,
+	} {
+		if err := json.Unmarshal([]byte(input), &restored); err == nil {
+			t.Errorf("UnmarshalJSON(%s) succeeded, want an error", input)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package goodmoney
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// PreciseMoney is an amount with extra decimals beyond its currency's minor unit,
+// for unit prices such as $0.00042 per API call or 1.2349 EUR per litre that New
+// rejects with ErrTooManyDecimalPlaces. Prices are multiplied by quantities and
+// summed at full precision, then settled to Money with an explicit RoundScheme.
+//
+// The amount is kept in int64 units of 10^-(MinorUnit+ExtraDigits), so a USD price
+// with 4 extra digits reaches ±922 billion dollars.
+type PreciseMoney struct {
+	amount   int64
+	extra    int
+	currency *currencyEntry
+}
+
+// NewPreciseFromString creates a PreciseMoney from a decimal string with up to
+// extraDigits decimals beyond the currency's minor unit.
+// Returns ErrInvalidAmount for malformed input or if the currency's minor unit plus
+// extraDigits isn't between 0 and 18, ErrTooManyDecimalPlaces if the amount has more
+// decimals and ErrOverflow or ErrUnderflow if it doesn't fit.
+//
+// Example:
+//
+//	perCall, err := NewPreciseFromString("0.00042", USD, 3)
+func NewPreciseFromString(amount string, currencyCode string, extraDigits int) (*PreciseMoney, error) {
+	c, err := preciseCurrency(currencyCode, extraDigits)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := parseDecimal(amount, c.MinorUnit+extraDigits)
+	if err != nil {
+		return nil, err
+	}
+	return &PreciseMoney{amount: units, extra: extraDigits, currency: c}, nil
+}
+
+// NewPreciseFromUnits creates a PreciseMoney from an amount in units of
+// 10^-(MinorUnit+extraDigits): 42 units of USD with 3 extra digits are $0.00042.
+// Returns ErrInvalidAmount if the currency's minor unit plus extraDigits isn't
+// between 0 and 18.
+func NewPreciseFromUnits(units int64, currencyCode string, extraDigits int) (*PreciseMoney, error) {
+	c, err := preciseCurrency(currencyCode, extraDigits)
+	if err != nil {
+		return nil, err
+	}
+	return &PreciseMoney{amount: units, extra: extraDigits, currency: c}, nil
+}
+
+// preciseCurrency returns the registry entry for code after checking that amounts
+// with extraDigits more decimals have an int64 scale.
+func preciseCurrency(code string, extraDigits int) (*currencyEntry, error) {
+	c, err := lookupCurrency(code)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkExtraDigits(extraDigits); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkExtraDigits checks that amounts of c with extraDigits more decimals have an
+// int64 scale.
+func (c *currencyEntry) checkExtraDigits(extraDigits int) error {
+	if extraDigits < 0 || c.MinorUnit+extraDigits >= len(pow10) {
+		return fmt.Errorf("%w: %d extra digits for %s", ErrInvalidAmount, extraDigits, c.code)
+	}
+	return nil
+}
+
+// Precise returns m with extraDigits more decimals, exactly.
+// Returns ErrInvalidAmount if the currency's minor unit plus extraDigits isn't
+// between 0 and 18, and ErrOverflow or ErrUnderflow if the amount doesn't fit.
+func (m Money) Precise(extraDigits int) (*PreciseMoney, error) {
+	if m.currency == nil {
+		return nil, ErrCurrencyMismatch
+	}
+	// keep the currency of m rather than looking it up again, so its scale stays
+	// the one the amount is in even if CurrencyMap was edited since
+	if err := m.currency.checkExtraDigits(extraDigits); err != nil {
+		return nil, err
+	}
+	units, err := multiplyInt64(m.amount, pow10[extraDigits])
+	if err != nil {
+		return nil, err
+	}
+	return &PreciseMoney{amount: units, extra: extraDigits, currency: m.currency}, nil
+}
+
+// Currency returns the currency code string (e.g., "USD", "EUR").
+// Returns empty string if currency is nil.
+func (p PreciseMoney) Currency() string {
+	if p.currency == nil {
+		return ""
+	}
+	return p.currency.code
+}
+
+// ExtraDigits returns the number of decimals p keeps beyond its currency's minor unit.
+func (p PreciseMoney) ExtraDigits() int {
+	return p.extra
+}
+
+// Units returns the amount in units of 10^-(MinorUnit+ExtraDigits).
+func (p PreciseMoney) Units() int64 {
+	return p.amount
+}
+
+// scale returns the number of decimals of p, its minor unit plus its extra digits.
+func (p PreciseMoney) scale() int {
+	if p.currency == nil {
+		return p.extra
+	}
+	return p.currency.MinorUnit + p.extra
+}
+
+// IsZero returns true if the amount is zero.
+func (p PreciseMoney) IsZero() bool {
+	return p.amount == 0
+}
+
+// DecimalString returns the exact amount as a plain decimal string with every
+// decimal of p: "0.00042" for 42 units of USD with 3 extra digits.
+func (p PreciseMoney) DecimalString() string {
+	var buf [24]byte
+	return string(appendDecimal(buf[:0], p.amount, p.scale()))
+}
+
+// Rat returns the exact amount in major units as a new big.Rat.
+func (p PreciseMoney) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(p.amount), big.NewInt(pow10[p.scale()]))
+}
+
+// String returns a string representation of PreciseMoney in the format "amount currency".
+// Example: "0.00042 USD"
+func (p PreciseMoney) String() string {
+	return p.DecimalString() + " " + p.Currency()
+}
+
+// Times returns p multiplied by a whole quantity.
+// Returns ErrOverflow or ErrUnderflow if the result doesn't fit.
+//
+// Example:
+//
+//	usage, err := perCall.Times(1_250_000) // 525.00000 USD
+func (p PreciseMoney) Times(quantity int64) (*PreciseMoney, error) {
+	units, err := multiplyInt64(p.amount, quantity)
+	if err != nil {
+		return nil, err
+	}
+	return &PreciseMoney{amount: units, extra: p.extra, currency: p.currency}, nil
+}
+
+// MulQuantity returns p multiplied by a fractional quantity such as 42.37 litres,
+// rounded to the precision of p with the given scheme.
+// Returns ErrDivisionByZero for the zero Factor and ErrOverflow or ErrUnderflow if
+// the result doesn't fit.
+//
+// Example:
+//
+//	litres, _ := ParseFactor("42.37")
+//	fuel, err := perLitre.MulQuantity(litres, RoundHalfEven)
+func (p PreciseMoney) MulQuantity(quantity Factor, scheme RoundScheme) (*PreciseMoney, error) {
+	units, err := mulDivRound(p.amount, quantity.num, quantity.den, scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &PreciseMoney{amount: units, extra: p.extra, currency: p.currency}, nil
+}
+
+// SumPrecise adds one or more PreciseMoney values of the same currency exactly. The
+// sum keeps the largest number of extra digits among them.
+// Returns ErrCurrencyMismatch if currencies don't match or any value is nil, and
+// ErrOverflow or ErrUnderflow if the sum doesn't fit.
+//
+// Example:
+//
+//	total, err := SumPrecise(calls, storage, egress)
+func SumPrecise(ps ...*PreciseMoney) (*PreciseMoney, error) {
+	if len(ps) == 0 {
+		return nil, ErrNeedAtLeastOneMoney
+	}
+	if ps[0] == nil || ps[0].currency == nil {
+		return nil, ErrCurrencyMismatch
+	}
+
+	extra := 0
+	for _, p := range ps {
+		if p == nil || !p.currency.sameAs(ps[0].currency) {
+			return nil, ErrCurrencyMismatch
+		}
+		extra = max(extra, p.extra)
+	}
+
+	var result int64
+	for _, p := range ps {
+		units, err := multiplyInt64(p.amount, pow10[extra-p.extra])
+		if err != nil {
+			return nil, err
+		}
+		result, err = addInt64(result, units)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &PreciseMoney{amount: result, extra: extra, currency: ps[0].currency}, nil
+}
+
+// Add returns the sum of p and one or more PreciseMoney values, see SumPrecise.
+func (p PreciseMoney) Add(others ...*PreciseMoney) (*PreciseMoney, error) {
+	if len(others) == 0 {
+		return nil, ErrNeedAtLeastOneMoney
+	}
+	return SumPrecise(append([]*PreciseMoney{&p}, others...)...)
+}
+
+// Settle rounds p to its currency's minor unit with the given scheme and returns the
+// settled Money together with the residual p minus the settled amount, at the
+// precision of p, so that settled plus residual is exactly p. Carrying the residual
+// into the next settlement keeps a running total from drifting.
+// Returns ErrOverflow or ErrUnderflow if the rounded amount doesn't fit.
+//
+// Example:
+//
+//	usage, _ := NewPreciseFromString("525.004375", USD, 4)
+//	bill, residual, err := usage.Settle(RoundHalfEven) // 525.00 USD, 0.004375 USD
+func (p PreciseMoney) Settle(scheme RoundScheme) (*Money, *PreciseMoney, error) {
+	if p.currency == nil {
+		return nil, nil, ErrCurrencyMismatch
+	}
+
+	increment := pow10[p.extra]
+	rounded, err := roundUnits(p.amount, increment, scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settled := &Money{amount: rounded / increment, currency: p.currency}
+	residual := &PreciseMoney{amount: p.amount - rounded, extra: p.extra, currency: p.currency}
+	return settled, residual, nil
+}
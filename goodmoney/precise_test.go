@@ -0,0 +1,175 @@
+package goodmoney
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPreciseFromString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount  string
+		code    string
+		extra   int
+		want    string
+		wantErr error
+	}{
+		{amount: "0.00042", code: USD, extra: 3, want: "0.00042 USD"},
+		{amount: "1.2349", code: EUR, extra: 2, want: "1.2349 EUR"},
+		{amount: "0.5", code: JPY, extra: 4, want: "0.5000 JPY"},
+		{amount: "19.99", code: USD, extra: 0, want: "19.99 USD"},
+		{amount: "0.000421", code: USD, extra: 3, wantErr: ErrTooManyDecimalPlaces},
+		{amount: "abc", code: USD, extra: 3, wantErr: ErrInvalidAmount},
+		{amount: "1", code: USD, extra: -1, wantErr: ErrInvalidAmount},
+		{amount: "1", code: USD, extra: 17, wantErr: ErrInvalidAmount},
+		{amount: "100000000000", code: USD, extra: 8, wantErr: ErrOverflow},
+		{amount: "1", code: "XYZ", extra: 3, wantErr: ErrCurrencyCodeDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		got, err := NewPreciseFromString(tt.amount, tt.code, tt.extra)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("NewPreciseFromString(%q, %d) error = %v, want %v", tt.amount, tt.extra, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("NewPreciseFromString(%q, %d) = %s, want %s", tt.amount, tt.extra, got, tt.want)
+		}
+	}
+}
+
+func TestPreciseConversions(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewPreciseFromUnits(42, USD, 3)
+	if err != nil || p.DecimalString() != "0.00042" || p.Units() != 42 || p.ExtraDigits() != 3 {
+		t.Fatalf("NewPreciseFromUnits() = %v, %v, want 0.00042 USD", p, err)
+	}
+	if got := p.Rat().RatString(); got != "21/50000" {
+		t.Errorf("Rat() = %s, want 21/50000", got)
+	}
+
+	m := mustMinorUnits(1999, USD)
+	precise, err := m.Precise(4)
+	if err != nil || precise.String() != "19.990000 USD" {
+		t.Errorf("Precise(4) = %v, %v, want 19.990000 USD", precise, err)
+	}
+	if _, err := mustMinorUnits(1<<62, USD).Precise(4); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Precise(4) error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := (Money{}).Precise(4); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Precise() without currency error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+}
+
+func TestPreciseQuantities(t *testing.T) {
+	t.Parallel()
+
+	perCall, _ := NewPreciseFromString("0.00042", USD, 3)
+	usage, err := perCall.Times(1_250_003)
+	if err != nil || usage.DecimalString() != "525.00126" {
+		t.Errorf("Times() = %v, %v, want 525.00126", usage, err)
+	}
+	if _, err := perCall.Times(1 << 62); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Times() error = %v, want %v", err, ErrOverflow)
+	}
+
+	perLitre, _ := NewPreciseFromString("1.799", EUR, 1)
+	litres, _ := ParseFactor("42.37")
+	fuel, err := perLitre.MulQuantity(litres, RoundHalfEven)
+	if err != nil || fuel.DecimalString() != "76.224" {
+		t.Errorf("MulQuantity() = %v, %v, want 76.224", fuel, err)
+	}
+	if _, err := perLitre.MulQuantity(Factor{}, RoundHalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("MulQuantity(zero Factor) error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestSumPrecise(t *testing.T) {
+	t.Parallel()
+
+	calls, _ := NewPreciseFromString("0.00042", USD, 3)
+	storage, _ := NewPreciseFromString("1.2345", USD, 2)
+	fee := mustMinorUnits(100, USD)
+
+	total, err := SumPrecise(calls, storage)
+	if err != nil || total.String() != "1.23492 USD" || total.ExtraDigits() != 3 {
+		t.Errorf("SumPrecise() = %v, %v, want 1.23492 USD", total, err)
+	}
+	feePrecise, _ := fee.Precise(0)
+	total, err = total.Add(feePrecise)
+	if err != nil || total.String() != "2.23492 USD" {
+		t.Errorf("Add() = %v, %v, want 2.23492 USD", total, err)
+	}
+
+	euro, _ := NewPreciseFromString("1", EUR, 2)
+	huge, _ := NewPreciseFromUnits(1<<62, USD, 0)
+	tests := []struct {
+		name    string
+		ps      []*PreciseMoney
+		wantErr error
+	}{
+		{name: "empty", wantErr: ErrNeedAtLeastOneMoney},
+		{name: "nil", ps: []*PreciseMoney{calls, nil}, wantErr: ErrCurrencyMismatch},
+		{name: "currencies", ps: []*PreciseMoney{calls, euro}, wantErr: ErrCurrencyMismatch},
+		{name: "overflow aligning", ps: []*PreciseMoney{calls, huge}, wantErr: ErrOverflow},
+		{name: "overflow adding", ps: []*PreciseMoney{huge, huge}, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		if _, err := SumPrecise(tt.ps...); !errors.Is(err, tt.wantErr) {
+			t.Errorf("SumPrecise(%s) error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestPreciseSettle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount       string
+		extra        int
+		scheme       RoundScheme
+		want         string
+		wantResidual string
+	}{
+		{amount: "525.004375", extra: 4, scheme: RoundHalfEven, want: "525.00", wantResidual: "0.004375"},
+		{amount: "525.005", extra: 1, scheme: RoundHalfEven, want: "525.00", wantResidual: "0.005"},
+		{amount: "525.015", extra: 1, scheme: RoundHalfEven, want: "525.02", wantResidual: "-0.005"},
+		{amount: "0.00042", extra: 3, scheme: RoundCeiling, want: "0.01", wantResidual: "-0.00958"},
+		{amount: "-1.23456", extra: 3, scheme: RoundHalfUp, want: "-1.23", wantResidual: "-0.00456"},
+		{amount: "7.50", extra: 0, scheme: RoundFloor, want: "7.50", wantResidual: "0.00"},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPreciseFromString(tt.amount, USD, tt.extra)
+		if err != nil {
+			t.Fatalf("NewPreciseFromString(%q) unexpected error: %v", tt.amount, err)
+		}
+		settled, residual, err := p.Settle(tt.scheme)
+		if err != nil {
+			t.Errorf("Settle(%s) unexpected error: %v", tt.amount, err)
+			continue
+		}
+		if settled.DecimalString() != tt.want || residual.DecimalString() != tt.wantResidual {
+			t.Errorf("Settle(%s, %s) = %s, %s, want %s, %s", tt.amount, tt.scheme, settled.DecimalString(), residual.DecimalString(), tt.want, tt.wantResidual)
+		}
+		back, err := SumPrecise(mustPrecise(t, settled, tt.extra), residual)
+		if err != nil || back.amount != p.amount {
+			t.Errorf("settled + residual = %v, %v, want %s", back, err, p)
+		}
+	}
+
+	if _, _, err := (PreciseMoney{}).Settle(RoundHalfUp); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Settle() without currency error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+}
+
+func mustPrecise(t *testing.T, m *Money, extra int) *PreciseMoney {
+	t.Helper()
+	p, err := m.Precise(extra)
+	if err != nil {
+		t.Fatalf("Precise(%d) unexpected error: %v", extra, err)
+	}
+	return p
+}
@@ -0,0 +1,280 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidMarkup happens when a spread or markup is negative, malformed or
+// takes the whole amount.
+var ErrInvalidMarkup = errors.New("invalid markup")
+
+// Side is the side of a customer conversion.
+type Side int
+
+const (
+	// Sell means the customer sells the given amount and receives the counter currency.
+	Sell Side = iota
+	// Buy means the customer buys the given amount and pays in the counter currency.
+	Buy
+)
+
+// String returns "sell" or "buy".
+func (s Side) String() string {
+	if s == Buy {
+		return "buy"
+	}
+	return "sell"
+}
+
+// PricingPolicy is what a customer is charged on top of the mid-market rate.
+// Spread and Markup are fractions of the amount paid (1/200 for 0.5%, see
+// ParseMarkup and Percent.Rat); nil means none. The margin is charged in the
+// currency the customer pays, so the customer rate is the mid-market rate times
+// 1-(Spread+Markup).
+type PricingPolicy struct {
+	// Spread is the half bid/ask spread taken on each side of the mid-market rate.
+	Spread *big.Rat
+	// Markup is added on top of the spread, typically per corridor.
+	Markup *big.Rat
+	// MinFees are the smallest margins charged, at most one per currency the
+	// customer pays. Conversions paid in a currency without one have no minimum.
+	MinFees []*Money
+}
+
+// fraction returns Spread+Markup, checking the policy.
+func (p PricingPolicy) fraction() (*big.Rat, error) {
+	f := new(big.Rat)
+	for _, r := range []*big.Rat{p.Spread, p.Markup} {
+		if r == nil {
+			continue
+		}
+		if r.Sign() < 0 {
+			return nil, ErrInvalidMarkup
+		}
+		f.Add(f, r)
+	}
+	if f.Cmp(big.NewRat(1, 1)) >= 0 {
+		return nil, ErrInvalidMarkup
+	}
+	for i, fee := range p.MinFees {
+		if fee == nil || fee.currency == nil || fee.amount < 0 {
+			return nil, ErrInvalidMarkup
+		}
+		for _, other := range p.MinFees[:i] {
+			if other.currency.sameAs(fee.currency) {
+				return nil, fmt.Errorf("%w: two minimum fees in %s", ErrInvalidMarkup, fee.currency.code)
+			}
+		}
+	}
+	return f, nil
+}
+
+// minFee returns the minimum fee of conversions paid in paid, nil if there is none.
+func (p PricingPolicy) minFee(paid *currencyEntry) *Money {
+	for _, fee := range p.MinFees {
+		if fee.currency.sameAs(paid) {
+			return fee
+		}
+	}
+	return nil
+}
+
+// ParseMarkup parses a percentage ("0.5%") or a number of basis points ("25bp" or
+// "25bps") with ParsePercent into an exact fraction. The unit is required: a bare
+// number is rejected rather than read as a fraction or as percent.
+// Returns ErrInvalidMarkup for malformed, unitless or negative values.
+//
+// Example:
+//
+//	spread, _ := ParseMarkup("0.35%")  // 7/2000
+//	markup, _ := ParseMarkup("25bp")   // 1/400
+func ParseMarkup(s string) (*big.Rat, error) {
+	value := strings.TrimSpace(s)
+	if !strings.HasSuffix(value, "%") && !strings.HasSuffix(value, "bp") && !strings.HasSuffix(value, "bps") {
+		return nil, fmt.Errorf("%w: %q has no %% or bp unit", ErrInvalidMarkup, s)
+	}
+
+	p, err := ParsePercent(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMarkup, err)
+	}
+	r := p.Rat()
+	if r.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidMarkup, s)
+	}
+	return r, nil
+}
+
+// PricedConversion is the result of a customer conversion.
+type PricedConversion struct {
+	Side Side
+	// Paid is what the customer pays, margin included.
+	Paid *Money
+	// Received is what the customer receives.
+	Received *Money
+	// Margin is what was earned, in the currency of Paid.
+	Margin *Money
+	// Rate is the mid-market rate from the currency of Paid to the currency of Received.
+	Rate Rate
+}
+
+// Pricer converts Money for customers, applying a PricingPolicy on top of the
+// mid-market rates of a Converter. Corridors, the pairs of the currency paid and
+// the currency received, can have their own policy. It is safe for concurrent use.
+type Pricer struct {
+	converter *Converter
+	policy    PricingPolicy
+
+	mu        sync.RWMutex
+	corridors map[string]PricingPolicy
+}
+
+// NewPricer returns a Pricer that gets mid-market rates and rounds amounts with
+// converter, and applies policy to corridors without their own policy.
+// Returns ErrInvalidMarkup for an invalid policy.
+//
+// Example:
+//
+//	spread, _ := ParseMarkup("0.5%")
+//	pricer, err := NewPricer(converter, PricingPolicy{Spread: spread})
+//	quote, err := pricer.Quote(ctx, usd, ETB, Sell)
+func NewPricer(converter *Converter, policy PricingPolicy) (*Pricer, error) {
+	if _, err := policy.fraction(); err != nil {
+		return nil, err
+	}
+	policy.MinFees = slices.Clone(policy.MinFees)
+	return &Pricer{
+		converter: converter,
+		policy:    policy,
+		corridors: make(map[string]PricingPolicy),
+	}, nil
+}
+
+// SetCorridor sets the policy of conversions paid in from and received in to.
+// Returns ErrCurrencyCodeDoesNotExist for unknown currencies, ErrInvalidMarkup
+// for an invalid policy and ErrCurrencyMismatch for a minimum fee not in from.
+func (p *Pricer) SetCorridor(from, to string, policy PricingPolicy) error {
+	paid, err := lookupCurrency(from)
+	if err != nil {
+		return err
+	}
+	if !ValidateCurrency(to) {
+		return ErrCurrencyCodeDoesNotExist
+	}
+	if _, err := policy.fraction(); err != nil {
+		return err
+	}
+	for _, fee := range policy.MinFees {
+		if !fee.currency.sameAs(paid) {
+			return fmt.Errorf("%w: minimum fee in %s for conversions paid in %s", ErrCurrencyMismatch, fee.currency.code, from)
+		}
+	}
+	policy.MinFees = slices.Clone(policy.MinFees)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.corridors[pairKey(from, to)] = policy
+	return nil
+}
+
+// Quote prices a customer conversion of m against the counter currency at the latest rate.
+// With Sell the customer pays m and receives counter, with Buy the customer receives m
+// and pays in counter. m must be positive.
+func (p *Pricer) Quote(ctx context.Context, m *Money, counter string, side Side) (*PricedConversion, error) {
+	return p.QuoteAt(ctx, m, counter, side, time.Time{})
+}
+
+// QuoteAt is Quote at the rate valid at the given time.
+// Returns ErrInvalidAmount when the margin takes the whole amount sold.
+func (p *Pricer) QuoteAt(ctx context.Context, m *Money, counter string, side Side, at time.Time) (*PricedConversion, error) {
+	if m == nil || m.currency == nil {
+		return nil, ErrCurrencyMismatch
+	}
+	if m.amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	other, err := lookupCurrency(counter)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := m.currency, other
+	if side == Buy {
+		from, to = other, m.currency
+	}
+
+	policy := p.corridor(from.code, to.code)
+	f, err := policy.fraction()
+	if err != nil {
+		return nil, err
+	}
+
+	rate := Rate{Base: from.code, Quote: to.code, Value: big.NewRat(1, 1)}
+	if !from.sameAs(to) {
+		if rate, err = p.converter.rate(ctx, from.code, to.code, at); err != nil {
+			return nil, err
+		}
+	}
+	scheme := p.converter.scheme
+
+	var paid, received, margin int64
+	if side == Buy {
+		// cost at mid-market, grossed up so that the margin is f of what is paid
+		inverse := new(big.Rat).Inv(rate.Value)
+		exact := exactConversion(m.amount, to, from, inverse)
+		cost := roundRat(exact, scheme)
+		exact.Mul(exact, new(big.Rat).Quo(f, new(big.Rat).Sub(big.NewRat(1, 1), f)))
+		gross := roundRat(exact, scheme)
+		if !cost.IsInt64() || !gross.IsInt64() {
+			return nil, ErrOverflow
+		}
+		margin = minFee(gross.Int64(), policy.minFee(from))
+		if paid, err = addInt64(cost.Int64(), margin); err != nil {
+			return nil, err
+		}
+		received = m.amount
+	} else {
+		exact := new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), f)
+		margin = minFee(roundRat(exact, scheme).Int64(), policy.minFee(from))
+		if margin >= m.amount {
+			return nil, fmt.Errorf("%w: margin takes the whole amount", ErrInvalidAmount)
+		}
+		paid = m.amount
+		if received, err = convertUnits(m.amount-margin, from, to, rate.Value, scheme); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PricedConversion{
+		Side:     side,
+		Paid:     &Money{amount: paid, currency: from},
+		Received: &Money{amount: received, currency: to},
+		Margin:   &Money{amount: margin, currency: from},
+		Rate:     rate,
+	}, nil
+}
+
+// corridor returns the policy of the from/to corridor.
+func (p *Pricer) corridor(from, to string) PricingPolicy {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if policy, ok := p.corridors[pairKey(from, to)]; ok {
+		return policy
+	}
+	return p.policy
+}
+
+// minFee raises margin to the minimum fee, in the currency paid, if there is one.
+func minFee(margin int64, fee *Money) int64 {
+	if fee != nil && margin < fee.amount {
+		return fee.amount
+	}
+	return margin
+}
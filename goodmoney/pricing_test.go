@@ -0,0 +1,232 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestParseMarkup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    *big.Rat
+		wantErr error
+	}{
+		{input: "0.5%", want: big.NewRat(1, 200)},
+		{input: "0.35 %", want: big.NewRat(7, 2000)},
+		{input: "25bp", want: big.NewRat(1, 400)},
+		{input: "25bps", want: big.NewRat(1, 400)},
+		{input: "0%", want: new(big.Rat)},
+		{input: "100/3%", want: big.NewRat(1, 3)},
+		{input: "0.005", wantErr: ErrInvalidMarkup},
+		{input: "0", wantErr: ErrInvalidMarkup},
+		{input: "-1%", wantErr: ErrInvalidMarkup},
+		{input: "abc", wantErr: ErrInvalidMarkup},
+		{input: "%", wantErr: ErrInvalidMarkup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseMarkup(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMarkup(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if tt.want != nil && got.Cmp(tt.want) != 0 {
+				t.Errorf("ParseMarkup(%q) = %s, want %s", tt.input, got.RatString(), tt.want.RatString())
+			}
+		})
+	}
+}
+
+func TestPricerQuote(t *testing.T) {
+	t.Parallel()
+
+	rates := NewStaticRateProvider()
+	_ = rates.SetRateString(USD, ETB, "100")
+	converter := NewConverter(rates, RoundHalfEven)
+
+	onePercent := func(t *testing.T) *Pricer {
+		spread, _ := ParseMarkup("0.5%")
+		markup, _ := ParseMarkup("50bp")
+		return mustPricer(t, converter, PricingPolicy{Spread: spread, Markup: markup})
+	}
+
+	tests := []struct {
+		name         string
+		pricer       func(t *testing.T) *Pricer
+		amount       *Money
+		counter      string
+		side         Side
+		wantPaid     string
+		wantReceived string
+		wantMargin   string
+	}{
+		{name: "sell", pricer: onePercent, amount: mustMinorUnits(10000, USD), counter: ETB, side: Sell, wantPaid: "100.00 USD", wantReceived: "9900.00 ETB", wantMargin: "1.00 USD"},
+		{name: "sell from the inverse pair", pricer: onePercent, amount: mustMinorUnits(990000, ETB), counter: USD, side: Sell, wantPaid: "9900.00 ETB", wantReceived: "98.01 USD", wantMargin: "99.00 ETB"},
+		{name: "buy", pricer: onePercent, amount: mustMinorUnits(1000000, ETB), counter: USD, side: Buy, wantPaid: "101.01 USD", wantReceived: "10000.00 ETB", wantMargin: "1.01 USD"},
+		{
+			name: "minimum fee",
+			pricer: func(t *testing.T) *Pricer {
+				p := onePercent(t)
+				p.policy.MinFees = []*Money{mustMinorUnits(200, USD)}
+				return p
+			},
+			amount: mustMinorUnits(10000, USD), counter: ETB, side: Sell, wantPaid: "100.00 USD", wantReceived: "9800.00 ETB", wantMargin: "2.00 USD",
+		},
+		{
+			name: "minimum fee per currency paid",
+			pricer: func(t *testing.T) *Pricer {
+				p := onePercent(t)
+				p.policy.MinFees = []*Money{mustMinorUnits(200, USD), mustMinorUnits(20000, ETB)}
+				return p
+			},
+			amount: mustMinorUnits(990000, ETB), counter: USD, side: Sell, wantPaid: "9900.00 ETB", wantReceived: "97.00 USD", wantMargin: "200.00 ETB",
+		},
+		{
+			name: "no minimum fee in the currency paid",
+			pricer: func(t *testing.T) *Pricer {
+				p := onePercent(t)
+				p.policy.MinFees = []*Money{mustMinorUnits(20000, ETB)}
+				return p
+			},
+			amount: mustMinorUnits(10000, USD), counter: ETB, side: Sell, wantPaid: "100.00 USD", wantReceived: "9900.00 ETB", wantMargin: "1.00 USD",
+		},
+		{
+			name: "corridor policy",
+			pricer: func(t *testing.T) *Pricer {
+				p := onePercent(t)
+				markup, _ := ParseMarkup("2%")
+				if err := p.SetCorridor(USD, ETB, PricingPolicy{Markup: markup}); err != nil {
+					t.Fatalf("SetCorridor() unexpected error: %v", err)
+				}
+				return p
+			},
+			amount: mustMinorUnits(10000, USD), counter: ETB, side: Sell, wantPaid: "100.00 USD", wantReceived: "9800.00 ETB", wantMargin: "2.00 USD",
+		},
+		{name: "no policy", pricer: func(t *testing.T) *Pricer { return mustPricer(t, converter, PricingPolicy{}) }, amount: mustMinorUnits(10000, USD), counter: ETB, side: Buy, wantPaid: "10000.00 ETB", wantReceived: "100.00 USD", wantMargin: "0.00 ETB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.pricer(t).Quote(context.Background(), tt.amount, tt.counter, tt.side)
+			if err != nil {
+				t.Fatalf("Quote() unexpected error: %v", err)
+			}
+			if got.Side != tt.side {
+				t.Errorf("Quote() side = %v, want %v", got.Side, tt.side)
+			}
+			if got.Paid.String() != tt.wantPaid || got.Received.String() != tt.wantReceived || got.Margin.String() != tt.wantMargin {
+				t.Errorf("Quote() = paid %s, received %s, margin %s; want paid %s, received %s, margin %s",
+					got.Paid, got.Received, got.Margin, tt.wantPaid, tt.wantReceived, tt.wantMargin)
+			}
+		})
+	}
+}
+
+func TestPricerQuoteErrors(t *testing.T) {
+	t.Parallel()
+
+	rates := NewStaticRateProvider()
+	_ = rates.SetRateString(USD, ETB, "100")
+	pricer := mustPricer(t, NewConverter(rates, RoundHalfEven), PricingPolicy{MinFees: []*Money{mustMinorUnits(500, USD)}})
+
+	tests := []struct {
+		name    string
+		amount  *Money
+		counter string
+		side    Side
+		wantErr error
+	}{
+		{name: "negative amount", amount: mustMinorUnits(-100, USD), counter: ETB, side: Sell, wantErr: ErrInvalidAmount},
+		{name: "zero amount", amount: mustMinorUnits(0, USD), counter: ETB, side: Buy, wantErr: ErrInvalidAmount},
+		{name: "fee takes the whole amount", amount: mustMinorUnits(500, USD), counter: ETB, side: Sell, wantErr: ErrInvalidAmount},
+		{name: "unknown currency", amount: mustMinorUnits(500, USD), counter: "XYZ", side: Sell, wantErr: ErrCurrencyCodeDoesNotExist},
+		{name: "no rate", amount: mustMinorUnits(5000, USD), counter: KES, side: Sell, wantErr: ErrRateNotFound},
+		{name: "no currency", counter: KES, side: Sell, wantErr: ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pricer.Quote(context.Background(), tt.amount, tt.counter, tt.side)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Quote() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPricerSetCorridor(t *testing.T) {
+	t.Parallel()
+
+	pricer := mustPricer(t, NewConverter(NewStaticRateProvider(), RoundHalfEven), PricingPolicy{})
+
+	tests := []struct {
+		name    string
+		from    string
+		policy  PricingPolicy
+		wantErr error
+	}{
+		{name: "valid", from: USD, policy: PricingPolicy{Spread: big.NewRat(1, 100)}},
+		{name: "negative", from: USD, policy: PricingPolicy{Spread: big.NewRat(-1, 100)}, wantErr: ErrInvalidMarkup},
+		{name: "whole amount", from: USD, policy: PricingPolicy{Spread: big.NewRat(1, 2), Markup: big.NewRat(1, 2)}, wantErr: ErrInvalidMarkup},
+		{name: "negative minimum fee", from: USD, policy: PricingPolicy{MinFees: []*Money{mustMinorUnits(-1, USD)}}, wantErr: ErrInvalidMarkup},
+		{name: "minimum fee in another currency", from: USD, policy: PricingPolicy{MinFees: []*Money{mustMinorUnits(200, EUR)}}, wantErr: ErrCurrencyMismatch},
+		{name: "minimum fee in the currency received", from: USD, policy: PricingPolicy{MinFees: []*Money{mustMinorUnits(200, ETB)}}, wantErr: ErrCurrencyMismatch},
+		{name: "unknown currency", from: "XYZ", wantErr: ErrCurrencyCodeDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := pricer.SetCorridor(tt.from, ETB, tt.policy); !errors.Is(err, tt.wantErr) {
+				t.Errorf("SetCorridor() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewPricerValidatesPolicy(t *testing.T) {
+	t.Parallel()
+
+	converter := NewConverter(NewStaticRateProvider(), RoundHalfEven)
+	invalid := []PricingPolicy{
+		{Spread: big.NewRat(-1, 100)},
+		{MinFees: []*Money{nil}},
+		{MinFees: []*Money{mustMinorUnits(-1, USD)}},
+		{MinFees: []*Money{mustMinorUnits(200, USD), mustMinorUnits(300, USD)}},
+	}
+	for _, policy := range invalid {
+		if _, err := NewPricer(converter, policy); !errors.Is(err, ErrInvalidMarkup) {
+			t.Errorf("NewPricer(%+v) error = %v, want %v", policy, err, ErrInvalidMarkup)
+		}
+	}
+}
+
+// mustPricer returns a Pricer, failing the test on error.
+func mustPricer(t *testing.T, converter *Converter, policy PricingPolicy) *Pricer {
+	t.Helper()
+	p, err := NewPricer(converter, policy)
+	if err != nil {
+		t.Fatalf("NewPricer() unexpected error: %v", err)
+	}
+	return p
+}
+
+// mustMinorUnits returns units minor units of currency, panicking on error.
+func mustMinorUnits(units int64, currency string) *Money {
+	m, err := NewFromMinorUnits(units, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
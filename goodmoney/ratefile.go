@@ -0,0 +1,206 @@
+package goodmoney
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRateFile happens when a rate file is malformed.
+var ErrInvalidRateFile = errors.New("invalid rate file")
+
+// RateFileOptions controls how rate files are read.
+type RateFileOptions struct {
+	// SkipUnknownCurrencies drops rates of currencies missing from CurrencyMap,
+	// such as the legacy currencies of the ECB history, instead of failing.
+	SkipUnknownCurrencies bool
+}
+
+// ecbEnvelope is the structure of the ECB eurofxref XML files.
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ReadECBXML reads the ECB euro foreign exchange reference rates in their XML form
+// (eurofxref-daily.xml, eurofxref-hist.xml). Every rate is quoted against EUR.
+// Returns an error wrapping ErrCurrencyCodeDoesNotExist for codes that fail
+// ValidateCurrency unless opts.SkipUnknownCurrencies is set.
+//
+// Example:
+//
+//	f, _ := os.Open("eurofxref-hist.xml")
+//	rates, err := ReadECBXML(f, RateFileOptions{})
+//	err = store.AddRates(rates...)
+func ReadECBXML(r io.Reader, opts RateFileOptions) ([]Rate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRateFile, err)
+	}
+
+	var rates []Rate
+	for _, day := range envelope.Days {
+		date, err := parseRateDate(day.Time)
+		if err != nil {
+			return nil, err
+		}
+		for _, cube := range day.Rates {
+			rate, ok, err := newFileRate(EUR, cube.Currency, cube.Rate, date, "ECB", opts)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", day.Time, err)
+			}
+			if ok {
+				rates = append(rates, rate)
+			}
+		}
+	}
+	return rates, nil
+}
+
+// ReadECBCSV reads the ECB euro foreign exchange reference rates in their CSV form:
+// a "Date, USD, JPY, ..." header and one row per day, as in eurofxref.csv and
+// eurofxref-hist.csv. Every rate is quoted against EUR, "N/A" cells are skipped.
+// Currency codes are validated like ReadECBXML.
+func ReadECBCSV(r io.Reader, opts RateFileOptions) ([]Rate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRateFile, err)
+	}
+	if len(header) == 0 || !strings.EqualFold(strings.TrimSpace(header[0]), "date") {
+		return nil, fmt.Errorf("%w: missing Date header", ErrInvalidRateFile)
+	}
+
+	var rates []Rate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRateFile, err)
+		}
+
+		date, err := parseRateDate(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		for i := 1; i < len(record) && i < len(header); i++ {
+			code, value := strings.TrimSpace(header[i]), strings.TrimSpace(record[i])
+			// the files end every line with a comma, and list retired currencies as N/A
+			if code == "" || value == "" || value == "N/A" {
+				continue
+			}
+			rate, ok, err := newFileRate(EUR, code, value, date, "ECB", opts)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			if ok {
+				rates = append(rates, rate)
+			}
+		}
+	}
+	return rates, nil
+}
+
+// ReadRatesCSV reads a generic "date,base,quote,rate" CSV file, with or without
+// that header line. Dates are formatted as 2006-01-02 and rates as decimals.
+// Currency codes are validated like ReadECBXML.
+//
+// Example:
+//
+//	date,base,quote,rate
+//	2025-03-31,USD,ETB,57.3122
+func ReadRatesCSV(r io.Reader, opts RateFileOptions) ([]Rate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	var rates []Rate
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRateFile, err)
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+
+		date, err := parseRateDate(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		base := strings.TrimSpace(record[1])
+		if !ValidateCurrency(base) {
+			if opts.SkipUnknownCurrencies {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %w: %s", line, ErrCurrencyCodeDoesNotExist, base)
+		}
+		rate, ok, err := newFileRate(base, strings.TrimSpace(record[2]), strings.TrimSpace(record[3]), date, "CSV", opts)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if ok {
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+// newFileRate validates one rate read from a file. It returns false for a rate
+// of an unknown currency that should be skipped.
+func newFileRate(base, quote, value string, date time.Time, source string, opts RateFileOptions) (Rate, bool, error) {
+	if !ValidateCurrency(quote) {
+		if opts.SkipUnknownCurrencies {
+			return Rate{}, false, nil
+		}
+		return Rate{}, false, fmt.Errorf("%w: %s", ErrCurrencyCodeDoesNotExist, quote)
+	}
+
+	r, ok := new(big.Rat).SetString(value)
+	if !ok || r.Sign() <= 0 {
+		return Rate{}, false, fmt.Errorf("%w: %s %q", ErrInvalidRate, pairKey(base, quote), value)
+	}
+
+	return Rate{
+		Base:   base,
+		Quote:  quote,
+		Value:  r,
+		Time:   date,
+		Source: source,
+	}, true, nil
+}
+
+// rateDateLayouts are the date formats found in rate files.
+var rateDateLayouts = []string{
+	time.DateOnly,    // 2025-03-31
+	"2 January 2006", // 31 March 2025, ECB daily CSV
+}
+
+// parseRateDate parses a date of a rate file.
+func parseRateDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range rateDateLayouts {
+		if date, err := time.Parse(layout, s); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidRateFile, s)
+}
@@ -0,0 +1,229 @@
+package goodmoney
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadECBXML(t *testing.T) {
+	t.Parallel()
+
+	const daily = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2025-03-31">
+			<Cube currency="USD" rate="1.0815"/>
+			<Cube currency="JPY" rate="162.09"/>
+		</Cube>
+		<Cube time="2025-03-28">
+			<Cube currency="USD" rate="1.0790"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+	rates, err := ReadECBXML(strings.NewReader(daily), RateFileOptions{})
+	if err != nil {
+		t.Fatalf("ReadECBXML() unexpected error: %v", err)
+	}
+
+	want := []Rate{
+		{Base: EUR, Quote: USD, Value: big.NewRat(10815, 10000), Time: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), Source: "ECB"},
+		{Base: EUR, Quote: JPY, Value: big.NewRat(16209, 100), Time: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), Source: "ECB"},
+		{Base: EUR, Quote: USD, Value: big.NewRat(10790, 10000), Time: time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), Source: "ECB"},
+	}
+	assertRates(t, rates, want)
+}
+
+func TestReadECBXMLErrors(t *testing.T) {
+	t.Parallel()
+
+	cube := func(inner string) string {
+		return `<Envelope><Cube><Cube time="2008-01-02">` + inner + `</Cube></Cube></Envelope>`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    RateFileOptions
+		want    int
+		wantErr error
+	}{
+		{name: "legacy currency", input: cube(`<Cube currency="CYP" rate="0.585274"/>`), wantErr: ErrCurrencyCodeDoesNotExist},
+		{name: "legacy currency skipped", input: cube(`<Cube currency="CYP" rate="0.585274"/><Cube currency="USD" rate="1.4741"/>`), opts: RateFileOptions{SkipUnknownCurrencies: true}, want: 1},
+		{name: "malformed rate", input: cube(`<Cube currency="USD" rate="1,4741"/>`), wantErr: ErrInvalidRate},
+		{name: "zero rate", input: cube(`<Cube currency="USD" rate="0"/>`), wantErr: ErrInvalidRate},
+		{name: "malformed date", input: `<Envelope><Cube><Cube time="02/01/2008"></Cube></Cube></Envelope>`, wantErr: ErrInvalidRateFile},
+		{name: "malformed xml", input: `<Envelope><Cube>`, wantErr: ErrInvalidRateFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates, err := ReadECBXML(strings.NewReader(tt.input), tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadECBXML() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(rates) != tt.want {
+				t.Errorf("ReadECBXML() returned %d rates, want %d", len(rates), tt.want)
+			}
+		})
+	}
+}
+
+func TestReadECBCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []Rate
+	}{
+		{
+			name:  "daily",
+			input: "Date, USD, JPY, \n31 March 2025, 1.0815, 162.09, \n",
+			want: []Rate{
+				{Base: EUR, Quote: USD, Value: big.NewRat(10815, 10000), Time: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), Source: "ECB"},
+				{Base: EUR, Quote: JPY, Value: big.NewRat(16209, 100), Time: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), Source: "ECB"},
+			},
+		},
+		{
+			name:  "history with retired currencies",
+			input: "Date,USD,CYP,\n2025-03-31,1.0815,N/A,\n2025-03-28,1.0790,N/A,\n",
+			want: []Rate{
+				{Base: EUR, Quote: USD, Value: big.NewRat(10815, 10000), Time: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), Source: "ECB"},
+				{Base: EUR, Quote: USD, Value: big.NewRat(10790, 10000), Time: time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), Source: "ECB"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates, err := ReadECBCSV(strings.NewReader(tt.input), RateFileOptions{})
+			if err != nil {
+				t.Fatalf("ReadECBCSV() unexpected error: %v", err)
+			}
+			assertRates(t, rates, tt.want)
+		})
+	}
+}
+
+func TestReadECBCSVErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    RateFileOptions
+		want    int
+		wantErr error
+	}{
+		{name: "legacy currency", input: "Date,USD,CYP,\n2007-12-31,1.4721,0.585274,\n", wantErr: ErrCurrencyCodeDoesNotExist},
+		{name: "legacy currency skipped", input: "Date,USD,CYP,\n2007-12-31,1.4721,0.585274,\n", opts: RateFileOptions{SkipUnknownCurrencies: true}, want: 1},
+		{name: "negative rate", input: "Date,USD,\n2007-12-31,-1.4721,\n", wantErr: ErrInvalidRate},
+		{name: "malformed date", input: "Date,USD,\n31/12/2007,1.4721,\n", wantErr: ErrInvalidRateFile},
+		{name: "missing header", input: "2007-12-31,1.4721,\n", wantErr: ErrInvalidRateFile},
+		{name: "empty", input: "", wantErr: ErrInvalidRateFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates, err := ReadECBCSV(strings.NewReader(tt.input), tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadECBCSV() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(rates) != tt.want {
+				t.Errorf("ReadECBCSV() returned %d rates, want %d", len(rates), tt.want)
+			}
+		})
+	}
+}
+
+func TestReadRatesCSV(t *testing.T) {
+	t.Parallel()
+
+	want := []Rate{
+		{Base: USD, Quote: ETB, Value: big.NewRat(573122, 10000), Time: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), Source: "CSV"},
+		{Base: EUR, Quote: KES, Value: big.NewRat(13971, 100), Time: time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), Source: "CSV"},
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    RateFileOptions
+		want    []Rate
+		wantErr error
+	}{
+		{name: "with header", input: "date,base,quote,rate\n2025-03-31,USD,ETB,57.3122\n2025-03-28,EUR,KES,139.71\n", want: want},
+		{name: "without header", input: "2025-03-31,USD,ETB,57.3122\n2025-03-28, EUR, KES, 139.71\n", want: want},
+		{name: "unknown base", input: "2025-03-31,XYZ,ETB,57.3122\n", wantErr: ErrCurrencyCodeDoesNotExist},
+		{name: "unknown quote", input: "2025-03-31,USD,XYZ,57.3122\n", wantErr: ErrCurrencyCodeDoesNotExist},
+		{name: "unknown skipped", input: "2025-03-31,XYZ,ETB,1\n2025-03-31,USD,XYZ,1\n2025-03-31,USD,ETB,57.3122\n", opts: RateFileOptions{SkipUnknownCurrencies: true}, want: want[:1]},
+		{name: "malformed rate", input: "2025-03-31,USD,ETB,abc\n", wantErr: ErrInvalidRate},
+		{name: "malformed date", input: "2025-31-03,USD,ETB,57.3122\n", wantErr: ErrInvalidRateFile},
+		{name: "wrong column count", input: "2025-03-31,USD,ETB\n", wantErr: ErrInvalidRateFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates, err := ReadRatesCSV(strings.NewReader(tt.input), tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadRatesCSV() error = %v, want %v", err, tt.wantErr)
+			}
+			assertRates(t, rates, tt.want)
+		})
+	}
+}
+
+func TestReadRatesIntoHistoricalStore(t *testing.T) {
+	t.Parallel()
+
+	rates, err := ReadECBCSV(strings.NewReader("Date,USD,\n2025-03-31,1.0815,\n2025-03-28,1.0790,\n"), RateFileOptions{})
+	if err != nil {
+		t.Fatalf("ReadECBCSV() unexpected error: %v", err)
+	}
+
+	store := NewHistoricalRateStore(FallbackPrevious, 0)
+	if err := store.AddRates(rates...); err != nil {
+		t.Fatalf("AddRates() unexpected error: %v", err)
+	}
+
+	rate, err := store.Rate(context.Background(), EUR, USD, time.Date(2025, 3, 30, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Rate() unexpected error: %v", err)
+	}
+	if rate.Value.Cmp(big.NewRat(10790, 10000)) != 0 || rate.Source != "ECB" {
+		t.Errorf("Rate() = %v from %s, want 1.079 from ECB", rate.Value.FloatString(4), rate.Source)
+	}
+}
+
+// assertRates compares rates field by field.
+func assertRates(t *testing.T, got, want []Rate) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d rates, want %d", len(got), len(want))
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.Base != w.Base || g.Quote != w.Quote || g.Value.Cmp(w.Value) != 0 || !g.Time.Equal(w.Time) || g.Source != w.Source {
+			t.Errorf("rate %d = %s %s/%s %s from %s, want %s %s/%s %s from %s", i,
+				g.Time.Format(time.DateOnly), g.Base, g.Quote, g.Value.RatString(), g.Source,
+				w.Time.Format(time.DateOnly), w.Base, w.Quote, w.Value.RatString(), w.Source)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package goodmoney
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// roundRat rounds r to an integer with the given scheme. Ties follow the same
+// conventions as Round: RoundHalfUp goes toward positive infinity, RoundHalfDown
+// toward negative infinity and RoundHalfEven to the even neighbour.
+func roundRat(r *big.Rat, scheme RoundScheme) *big.Int {
+	num, den := r.Num(), r.Denom()
+
+	// Euclidean division: floor is the quotient, remainder is in [0, den)
+	floor, remainder := new(big.Int).DivMod(num, den, new(big.Int))
+	if remainder.Sign() == 0 {
+		return floor
+	}
+
+	// compare the remainder with half the denominator
+	half := new(big.Int).Lsh(remainder, 1).Cmp(den)
+
+	if roundUp(scheme, half, floor.Bit(0) == 1, num.Sign() < 0) {
+		floor.Add(floor, big.NewInt(1))
+	}
+	return floor
+}
+
+// roundUnits rounds amount to a multiple of increment, which must be positive,
+// with the same conventions as roundRat. Returns ErrOverflow or ErrUnderflow if
+// the multiple doesn't fit in int64.
+func roundUnits(amount, increment int64, scheme RoundScheme) (int64, error) {
+	// floored division: the remainder is in [0, increment)
+	quotient, remainder := amount/increment, amount%increment
+	if remainder < 0 {
+		quotient--
+		remainder += increment
+	}
+	if remainder == 0 {
+		return amount, nil
+	}
+
+	// compare the remainder with half the increment without overflowing
+	half := 0
+	if distance := increment - remainder; remainder < distance {
+		half = -1
+	} else if remainder > distance {
+		half = 1
+	}
+
+	if roundUp(scheme, half, quotient&1 == 1, amount < 0) {
+		return addInt64(amount, increment-remainder)
+	}
+	return subtractInt64(amount, remainder)
+}
+
+// roundUp reports whether a value strictly between two consecutive integers rounds
+// to the upper one. half compares the value's distance from the lower integer with
+// one half, odd tells whether the lower integer is odd.
+func roundUp(scheme RoundScheme, half int, odd, negative bool) bool {
+	switch scheme {
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd)
+	case RoundAwayFromZero:
+		return !negative
+	case RoundCeiling:
+		return true
+	case RoundFloor:
+		return false
+	default: // RoundTowardZero
+		return negative
+	}
+}
+
+// roundSchemeNames are the names of the rounding schemes, indexed by RoundScheme.
+var roundSchemeNames = [...]string{
+	RoundHalfUp:       "HalfUp",
+	RoundHalfDown:     "HalfDown",
+	RoundTowardZero:   "TowardZero",
+	RoundAwayFromZero: "AwayFromZero",
+	RoundHalfEven:     "HalfEven",
+	RoundCeiling:      "Ceiling",
+	RoundFloor:        "Floor",
+}
+
+// String returns the name of the rounding scheme without its Round prefix (e.g., "HalfEven").
+func (s RoundScheme) String() string {
+	if s < 0 || int(s) >= len(roundSchemeNames) {
+		return "RoundScheme(" + strconv.Itoa(int(s)) + ")"
+	}
+	return roundSchemeNames[s]
+}
+
+// parseRoundScheme returns the rounding scheme named name, as returned by String.
+func parseRoundScheme(name string) (RoundScheme, bool) {
+	for s, n := range roundSchemeNames {
+		if n == name {
+			return RoundScheme(s), true
+		}
+	}
+	return 0, false
+}
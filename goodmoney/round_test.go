@@ -0,0 +1,192 @@
+package goodmoney
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRoundRat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  *big.Rat
+		scheme RoundScheme
+		want   int64
+	}{
+		{name: "half up positive tie", value: big.NewRat(5, 2), scheme: RoundHalfUp, want: 3},
+		{name: "half up negative tie", value: big.NewRat(-5, 2), scheme: RoundHalfUp, want: -2},
+		{name: "half up negative below tie", value: big.NewRat(-27, 10), scheme: RoundHalfUp, want: -3},
+		{name: "half down positive tie", value: big.NewRat(5, 2), scheme: RoundHalfDown, want: 2},
+		{name: "half down negative tie", value: big.NewRat(-5, 2), scheme: RoundHalfDown, want: -3},
+		{name: "half down negative above tie", value: big.NewRat(-23, 10), scheme: RoundHalfDown, want: -2},
+		{name: "half even to even", value: big.NewRat(5, 2), scheme: RoundHalfEven, want: 2},
+		{name: "half even to odd", value: big.NewRat(7, 2), scheme: RoundHalfEven, want: 4},
+		{name: "half even negative", value: big.NewRat(-5, 2), scheme: RoundHalfEven, want: -2},
+		{name: "half even above tie", value: big.NewRat(26, 10), scheme: RoundHalfEven, want: 3},
+		{name: "toward zero positive", value: big.NewRat(29, 10), scheme: RoundTowardZero, want: 2},
+		{name: "toward zero negative", value: big.NewRat(-29, 10), scheme: RoundTowardZero, want: -2},
+		{name: "away from zero positive", value: big.NewRat(21, 10), scheme: RoundAwayFromZero, want: 3},
+		{name: "away from zero negative", value: big.NewRat(-21, 10), scheme: RoundAwayFromZero, want: -3},
+		{name: "ceiling negative", value: big.NewRat(-29, 10), scheme: RoundCeiling, want: -2},
+		{name: "floor negative", value: big.NewRat(-21, 10), scheme: RoundFloor, want: -3},
+		{name: "exact integer", value: big.NewRat(-4, 1), scheme: RoundCeiling, want: -4},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := roundRat(tt.value, tt.scheme); got.Int64() != tt.want {
+				t.Errorf("roundRat(%s) = %s, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundSchemeString(t *testing.T) {
+	t.Parallel()
+
+	for s := RoundHalfUp; s <= RoundFloor; s++ {
+		got, ok := parseRoundScheme(s.String())
+		if !ok || got != s {
+			t.Errorf("parseRoundScheme(%q) = %d, %v, want %d", s.String(), got, ok, s)
+		}
+	}
+	if got := RoundHalfEven.String(); got != "HalfEven" {
+		t.Errorf("RoundHalfEven.String() = %q, want %q", got, "HalfEven")
+	}
+	if got := RoundScheme(42).String(); got != "RoundScheme(42)" {
+		t.Errorf("RoundScheme(42).String() = %q, want %q", got, "RoundScheme(42)")
+	}
+	if _, ok := parseRoundScheme("Bankers"); ok {
+		t.Error("parseRoundScheme(\"Bankers\") should fail")
+	}
+}
+
+func TestRoundUnits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		amount    int64
+		increment int64
+		scheme    RoundScheme
+		want      int64
+		wantErr   error
+	}{
+		{name: "nickel half up", amount: 1243, increment: 5, scheme: RoundHalfUp, want: 1245},
+		{name: "nickel below half", amount: 1242, increment: 5, scheme: RoundHalfUp, want: 1240},
+		{name: "even increment tie half even", amount: 1250, increment: 100, scheme: RoundHalfEven, want: 1200},
+		{name: "odd quotient tie half even", amount: 1350, increment: 100, scheme: RoundHalfEven, want: 1400},
+		{name: "negative tie half up", amount: -1250, increment: 100, scheme: RoundHalfUp, want: -1200},
+		{name: "negative tie half down", amount: -1250, increment: 100, scheme: RoundHalfDown, want: -1300},
+		{name: "negative toward zero", amount: -1299, increment: 100, scheme: RoundTowardZero, want: -1200},
+		{name: "negative away from zero", amount: -1201, increment: 100, scheme: RoundAwayFromZero, want: -1300},
+		{name: "already a multiple", amount: -1200, increment: 100, scheme: RoundCeiling, want: -1200},
+		{name: "increment of one", amount: math.MinInt64, increment: 1, scheme: RoundFloor, want: math.MinInt64},
+		{name: "maximum rounded down", amount: math.MaxInt64, increment: 10, scheme: RoundFloor, want: math.MaxInt64 - 7},
+		{name: "maximum rounded up", amount: math.MaxInt64, increment: 10, scheme: RoundCeiling, wantErr: ErrOverflow},
+		{name: "minimum rounded down", amount: math.MinInt64 + 1, increment: 10, scheme: RoundFloor, wantErr: ErrUnderflow},
+		{name: "minimum rounded up", amount: math.MinInt64, increment: 10, scheme: RoundCeiling, want: math.MinInt64 + 8},
+		{name: "increment beyond the amount", amount: 600, increment: 1000, scheme: RoundHalfUp, want: 1000},
+		{name: "maximum increment", amount: math.MaxInt64 / 2, increment: math.MaxInt64, scheme: RoundHalfEven, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := roundUnits(tt.amount, tt.increment, tt.scheme)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("roundUnits() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("roundUnits(%d, %d, %v) = %d, want %d", tt.amount, tt.increment, tt.scheme, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRoundUnitsMatchesRat checks integer rounding against rounding the exact
+// quotient with big.Rat, on random amounts and increments and around the int64 limits.
+func TestRoundUnitsMatchesRat(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	amounts := []int64{0, 1, -1, math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1}
+	increments := []int64{1, 2, 3, 5, 10, 25, 50, 100, 1000, math.MaxInt64}
+	for range 2000 {
+		// random magnitudes, from a few digits up to the full int64 range
+		amounts = append(amounts, int64(rng.Uint64())>>rng.IntN(64))
+		increments = append(increments, 1+rng.Int64N(1<<rng.IntN(63)))
+	}
+
+	for i, amount := range amounts {
+		increment := increments[i%len(increments)]
+		for scheme := RoundHalfUp; scheme <= RoundFloor; scheme++ {
+			exact := roundRat(big.NewRat(amount, increment), scheme)
+			want := exact.Mul(exact, big.NewInt(increment))
+
+			got, err := roundUnits(amount, increment, scheme)
+			if !want.IsInt64() {
+				if err == nil {
+					t.Fatalf("roundUnits(%d, %d, %v) = %d, want an error for %s", amount, increment, scheme, got, want)
+				}
+				continue
+			}
+			if err != nil || got != want.Int64() {
+				t.Fatalf("roundUnits(%d, %d, %v) = %d, %v, want %s", amount, increment, scheme, got, err, want)
+			}
+		}
+	}
+}
+
+// TestMulDivRoundMatchesRat checks 128-bit multiply-divide rounding against big.Rat,
+// on random operands of every size and around the int64 limits.
+func TestMulDivRoundMatchesRat(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(3, 4))
+	edges := []int64{1, -1, 2, -2, 3, 7, 100, math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1}
+	operand := func() int64 {
+		if rng.IntN(4) == 0 {
+			return edges[rng.IntN(len(edges))]
+		}
+		return int64(rng.Uint64()) >> rng.IntN(64)
+	}
+
+	for range 5000 {
+		a, num, den := operand(), operand(), operand()
+		if den == 0 {
+			continue
+		}
+		for scheme := RoundHalfUp; scheme <= RoundFloor; scheme++ {
+			exact := new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(a), big.NewInt(num)), big.NewInt(den))
+			want := roundRat(exact, scheme)
+
+			got, err := mulDivRound(a, num, den, scheme)
+			if !want.IsInt64() {
+				wantErr := ErrOverflow
+				if want.Sign() < 0 {
+					wantErr = ErrUnderflow
+				}
+				if !errors.Is(err, wantErr) {
+					t.Fatalf("mulDivRound(%d, %d, %d, %v) = %d, %v, want %v for %s", a, num, den, scheme, got, err, wantErr, want)
+				}
+				continue
+			}
+			if err != nil || got != want.Int64() {
+				t.Fatalf("mulDivRound(%d, %d, %d, %v) = %d, %v, want %s", a, num, den, scheme, got, err, want)
+			}
+		}
+	}
+
+	if _, err := mulDivRound(1, 1, 0, RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("mulDivRound() error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
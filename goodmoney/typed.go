@@ -0,0 +1,197 @@
+package goodmoney
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CurrencyTag is implemented by zero-size types that name a currency at compile time,
+// such as the types of the iso4217 package. CurrencyCode must return a code of CurrencyMap.
+type CurrencyTag interface {
+	CurrencyCode() string
+}
+
+// Amount is Money whose currency is fixed at compile time by the tag type C.
+// Mixing currencies is a compile error:
+//
+//	price := AmountFromMinorUnits[iso4217.USD](1999)
+//	fee := AmountFromMinorUnits[iso4217.EUR](100)
+//	price.Plus(fee) // does not compile
+//
+// The zero value is zero in currency C. Amount is comparable with == and converts
+// to and from the dynamic Money type with AmountOf and Money.
+type Amount[C CurrencyTag] struct {
+	amount int64
+}
+
+// currencyOf returns the registry entry for the tag C.
+// It panics if the tag names a currency that doesn't exist, which is a programming error.
+func currencyOf[C CurrencyTag]() *currencyEntry {
+	var tag C
+	c, err := lookupCurrency(tag.CurrencyCode())
+	if err != nil {
+		panic(fmt.Sprintf("goodmoney: currency tag %T: %v", tag, err))
+	}
+	return c
+}
+
+// AmountFromMinorUnits returns an Amount of units minor units of currency C.
+//
+// Example:
+//
+//	price := AmountFromMinorUnits[iso4217.USD](1999) // $19.99
+func AmountFromMinorUnits[C CurrencyTag](units int64) Amount[C] {
+	return Amount[C]{amount: units}
+}
+
+// AmountFromString returns an Amount from an exact decimal string, see NewFromString.
+//
+// Example:
+//
+//	price, err := AmountFromString[iso4217.USD]("19.99")
+func AmountFromString[C CurrencyTag](amount string) (Amount[C], error) {
+	units, err := parseDecimal(amount, currencyOf[C]().MinorUnit)
+	if err != nil {
+		return Amount[C]{}, err
+	}
+	return Amount[C]{amount: units}, nil
+}
+
+// AmountOf converts a dynamic Money into an Amount of currency C.
+// Returns ErrCurrencyMismatch if m is nil or in another currency.
+//
+// Example:
+//
+//	m, _ := New(19.99, USD)
+//	price, err := AmountOf[iso4217.USD](m)
+func AmountOf[C CurrencyTag](m *Money) (Amount[C], error) {
+	if m == nil || m.currency == nil || !m.currency.sameAs(currencyOf[C]()) {
+		return Amount[C]{}, ErrCurrencyMismatch
+	}
+	return Amount[C]{amount: m.amount}, nil
+}
+
+// SumAmounts adds Amounts of the same currency.
+// Returns an error if overflow occurs.
+func SumAmounts[C CurrencyTag](as ...Amount[C]) (Amount[C], error) {
+	var result int64
+	for _, a := range as {
+		var err error
+		result, err = addInt64(result, a.amount)
+		if err != nil {
+			return Amount[C]{}, err
+		}
+	}
+	return Amount[C]{amount: result}, nil
+}
+
+// Money converts a back into the dynamic Money type.
+func (a Amount[C]) Money() Money {
+	return Money{amount: a.amount, currency: currencyOf[C]()}
+}
+
+// Currency returns the currency code of C (e.g., "USD").
+func (a Amount[C]) Currency() string {
+	var tag C
+	return tag.CurrencyCode()
+}
+
+// MinorUnits returns the amount expressed in minor units.
+func (a Amount[C]) MinorUnits() int64 {
+	return a.amount
+}
+
+// DecimalString returns the exact amount as a plain decimal string, see Money.DecimalString.
+func (a Amount[C]) DecimalString() string {
+	return a.Money().DecimalString()
+}
+
+// String returns the amount in the format "amount currency", see Money.String.
+func (a Amount[C]) String() string {
+	return a.Money().String()
+}
+
+// Plus returns a + b.
+// Returns an error if overflow occurs.
+func (a Amount[C]) Plus(b Amount[C]) (Amount[C], error) {
+	result, err := addInt64(a.amount, b.amount)
+	if err != nil {
+		return Amount[C]{}, err
+	}
+	return Amount[C]{amount: result}, nil
+}
+
+// Minus returns a - b.
+// Returns an error if overflow occurs.
+func (a Amount[C]) Minus(b Amount[C]) (Amount[C], error) {
+	result, err := subtractInt64(a.amount, b.amount)
+	if err != nil {
+		return Amount[C]{}, err
+	}
+	return Amount[C]{amount: result}, nil
+}
+
+// Times returns a multiplied by factor.
+// Returns an error if overflow occurs.
+func (a Amount[C]) Times(factor int64) (Amount[C], error) {
+	result, err := multiplyInt64(a.amount, factor)
+	if err != nil {
+		return Amount[C]{}, err
+	}
+	return Amount[C]{amount: result}, nil
+}
+
+// Neg returns the negative of a.
+func (a Amount[C]) Neg() Amount[C] {
+	return Amount[C]{amount: -a.amount}
+}
+
+// Abs returns the absolute value of a.
+func (a Amount[C]) Abs() Amount[C] {
+	return Amount[C]{amount: absInt64(a.amount)}
+}
+
+// LessThan returns true if a is less than b.
+func (a Amount[C]) LessThan(b Amount[C]) bool {
+	return a.amount < b.amount
+}
+
+// GreaterThan returns true if a is greater than b.
+func (a Amount[C]) GreaterThan(b Amount[C]) bool {
+	return a.amount > b.amount
+}
+
+// IsZero returns true if the amount is zero.
+func (a Amount[C]) IsZero() bool {
+	return a.amount == 0
+}
+
+// IsNegative returns true if the amount is negative.
+func (a Amount[C]) IsNegative() bool {
+	return a.amount < 0
+}
+
+// IsPositive returns true if the amount is positive.
+func (a Amount[C]) IsPositive() bool {
+	return a.amount > 0
+}
+
+// MarshalJSON implements json.Marshaler interface with the same format as Money.
+func (a Amount[C]) MarshalJSON() ([]byte, error) {
+	return a.Money().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface with the same format as Money.
+// Returns ErrCurrencyMismatch if the JSON currency isn't C.
+func (a *Amount[C]) UnmarshalJSON(data []byte) error {
+	var m Money
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	typed, err := AmountOf[C](&m)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal Amount[%s]: %w", a.Currency(), err)
+	}
+	*a = typed
+	return nil
+}
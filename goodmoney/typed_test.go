@@ -0,0 +1,153 @@
+package goodmoney
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+type testUSD struct{}
+
+func (testUSD) CurrencyCode() string { return USD }
+
+type testBHD struct{}
+
+func (testBHD) CurrencyCode() string { return BHD }
+
+type testUnknown struct{}
+
+func (testUnknown) CurrencyCode() string { return "INVALID" }
+
+func TestAmountConstructors(t *testing.T) {
+	t.Parallel()
+
+	a := AmountFromMinorUnits[testUSD](1999)
+	if a.MinorUnits() != 1999 || a.Currency() != USD || a.String() != "19.99 USD" {
+		t.Errorf("AmountFromMinorUnits() = %s, want 19.99 USD", a)
+	}
+
+	b, err := AmountFromString[testBHD]("1.005")
+	if err != nil {
+		t.Fatalf("AmountFromString() unexpected error: %v", err)
+	}
+	if b.MinorUnits() != 1005 || b.DecimalString() != "1.005" {
+		t.Errorf("AmountFromString() = %s, want 1.005 BHD", b)
+	}
+
+	if _, err := AmountFromString[testUSD]("1.001"); err != ErrTooManyDecimalPlaces {
+		t.Errorf("AmountFromString() error = %v, want %v", err, ErrTooManyDecimalPlaces)
+	}
+
+	var zero Amount[testUSD]
+	if !zero.IsZero() || zero.String() != "0.00 USD" {
+		t.Errorf("zero Amount = %s, want 0.00 USD", zero)
+	}
+}
+
+func TestAmountUnknownTagPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Amount with unknown currency tag did not panic")
+		}
+	}()
+	_ = AmountFromMinorUnits[testUnknown](1).String()
+}
+
+func TestAmountOf(t *testing.T) {
+	t.Parallel()
+
+	usd, _ := NewFromMinorUnits(1999, USD)
+	eur, _ := NewFromMinorUnits(1999, EUR)
+
+	a, err := AmountOf[testUSD](usd)
+	if err != nil {
+		t.Fatalf("AmountOf() unexpected error: %v", err)
+	}
+	if a.MinorUnits() != 1999 {
+		t.Errorf("AmountOf() = %s, want 19.99 USD", a)
+	}
+	if back := a.Money(); back != *usd {
+		t.Errorf("Money() = %s, want %s", &back, usd)
+	}
+
+	if _, err := AmountOf[testUSD](eur); err != ErrCurrencyMismatch {
+		t.Errorf("AmountOf(EUR) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := AmountOf[testUSD](nil); err != ErrCurrencyMismatch {
+		t.Errorf("AmountOf(nil) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := AmountOf[testUSD](&Money{amount: 1}); err != ErrCurrencyMismatch {
+		t.Errorf("AmountOf(no currency) error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	t.Parallel()
+
+	a := AmountFromMinorUnits[testUSD](1050)
+	b := AmountFromMinorUnits[testUSD](225)
+
+	if got, err := a.Plus(b); err != nil || got.MinorUnits() != 1275 {
+		t.Errorf("Plus() = %s, %v, want 12.75 USD", got, err)
+	}
+	if got, err := a.Minus(b); err != nil || got.MinorUnits() != 825 {
+		t.Errorf("Minus() = %s, %v, want 8.25 USD", got, err)
+	}
+	if got, err := a.Times(3); err != nil || got.MinorUnits() != 3150 {
+		t.Errorf("Times() = %s, %v, want 31.50 USD", got, err)
+	}
+	if got, err := SumAmounts(a, b, b); err != nil || got.MinorUnits() != 1500 {
+		t.Errorf("SumAmounts() = %s, %v, want 15.00 USD", got, err)
+	}
+	if got := a.Neg(); got.MinorUnits() != -1050 || !got.IsNegative() {
+		t.Errorf("Neg() = %s, want -10.50 USD", got)
+	}
+	if got := a.Neg().Abs(); got != a || !got.IsPositive() {
+		t.Errorf("Abs() = %s, want 10.50 USD", got)
+	}
+	if !b.LessThan(a) || !a.GreaterThan(b) || a.LessThan(a) {
+		t.Error("LessThan()/GreaterThan() disagree with the amounts")
+	}
+
+	max := AmountFromMinorUnits[testUSD](math.MaxInt64)
+	if _, err := max.Plus(b); err != ErrOverflow {
+		t.Errorf("Plus() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := max.Neg().Minus(b); err != ErrUnderflow {
+		t.Errorf("Minus() error = %v, want %v", err, ErrUnderflow)
+	}
+	if _, err := max.Times(2); err != ErrOverflow {
+		t.Errorf("Times() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := SumAmounts(max, b); err != ErrOverflow {
+		t.Errorf("SumAmounts() error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	t.Parallel()
+
+	a := AmountFromMinorUnits[testUSD](10050)
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	if string(data) != `{"amount":100.5,"currency":"USD"}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+
+	var back Amount[testUSD]
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+	if back != a {
+		t.Errorf("json.Unmarshal() = %s, want %s", back, a)
+	}
+
+	var wrong Amount[testBHD]
+	if err := json.Unmarshal(data, &wrong); err == nil {
+		t.Error("json.Unmarshal() into another currency expected error, got nil")
+	}
+}
@@ -1,8 +1,149 @@
 package goodmoney
 
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// pow10 holds every power of ten that fits in an int64, indexed by exponent.
+var pow10 = [...]int64{
+	1,
+	10,
+	100,
+	1_000,
+	10_000,
+	100_000,
+	1_000_000,
+	10_000_000,
+	100_000_000,
+	1_000_000_000,
+	10_000_000_000,
+	100_000_000_000,
+	1_000_000_000_000,
+	10_000_000_000_000,
+	100_000_000_000_000,
+	1_000_000_000_000_000,
+	10_000_000_000_000_000,
+	100_000_000_000_000_000,
+	1_000_000_000_000_000_000,
+}
+
 func absInt64(x int64) int64 {
 	if x < 0 {
 		return -x
 	}
 	return x
 }
+
+// bigPow10 returns 10^n as a new big.Int.
+func bigPow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// addInt64 returns a + b, or ErrOverflow/ErrUnderflow if the sum doesn't fit in int64.
+func addInt64(a, b int64) (int64, error) {
+	if b > 0 && a > math.MaxInt64-b {
+		return 0, ErrOverflow
+	}
+	if b < 0 && a < math.MinInt64-b {
+		return 0, ErrUnderflow
+	}
+	return a + b, nil
+}
+
+// subtractInt64 returns a - b, or ErrOverflow/ErrUnderflow if the difference doesn't fit in int64.
+func subtractInt64(a, b int64) (int64, error) {
+	if b < 0 && a > math.MaxInt64+b {
+		return 0, ErrOverflow
+	}
+	if b > 0 && a < math.MinInt64+b {
+		return 0, ErrUnderflow
+	}
+	return a - b, nil
+}
+
+// multiplyInt64 returns a * b, or ErrOverflow/ErrUnderflow if the product doesn't fit in int64.
+func multiplyInt64(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	// Check positive overflow (a * b > MaxInt64)
+	if a > 0 && b > 0 && a > math.MaxInt64/b {
+		return 0, ErrOverflow
+	}
+	// Check negative overflow (a * b < MinInt64)
+	if a > 0 && b < 0 && b < math.MinInt64/a {
+		return 0, ErrUnderflow
+	}
+	if a < 0 && b > 0 && a < math.MinInt64/b {
+		return 0, ErrUnderflow
+	}
+	// For negative * negative, check if result would overflow MaxInt64
+	if a < 0 && b < 0 && a < math.MaxInt64/b {
+		return 0, ErrOverflow
+	}
+	return a * b, nil
+}
+
+// mulDivRound returns a * num / den rounded with scheme. The product is computed on
+// 128 bits, so only a quotient that doesn't fit in int64 fails, with ErrOverflow or
+// ErrUnderflow. Returns ErrDivisionByZero if den is zero.
+func mulDivRound(a, num, den int64, scheme RoundScheme) (int64, error) {
+	if den == 0 {
+		return 0, ErrDivisionByZero
+	}
+	negative := (a < 0) != (num < 0) != (den < 0)
+	if a == 0 || num == 0 {
+		return 0, nil
+	}
+
+	// divide the magnitudes: |a * num| = q * |den| + r
+	d := absUint64(den)
+	hi, lo := bits.Mul64(absUint64(a), absUint64(num))
+	if hi >= d {
+		return 0, overflowError(negative)
+	}
+	q, r := bits.Div64(hi, lo, d)
+
+	if r != 0 {
+		// the exact value lies strictly between two integers: q and q+1 when
+		// positive, -(q+1) and -q when negative
+		lower, distance := q, r
+		if negative {
+			lower, distance = q+1, d-r
+		}
+		half := 0
+		if distance < d-distance {
+			half = -1
+		} else if distance > d-distance {
+			half = 1
+		}
+		// away from zero when rounding up a positive value or down a negative one
+		if roundUp(scheme, half, lower&1 == 1, negative) != negative {
+			if q == math.MaxUint64 {
+				return 0, overflowError(negative)
+			}
+			q++
+		}
+	}
+
+	if negative {
+		if q > 1<<63 {
+			return 0, ErrUnderflow
+		}
+		return -int64(q), nil
+	}
+	if q > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(q), nil
+}
+
+// absUint64 returns the magnitude of x, which fits in uint64 even for the minimum int64.
+func absUint64(x int64) uint64 {
+	if x < 0 {
+		return -uint64(x)
+	}
+	return uint64(x)
+}
@@ -0,0 +1,145 @@
+package goodmoney
+
+import "math"
+
+// The functions and methods in this file are the value counterpart of the pointer API:
+// they take and return Money by value, so hot loops can sum and scale amounts without
+// allocating. Money values are comparable with == and usable as map keys, two values
+// are equal exactly when their amounts and currencies are.
+//
+// Example:
+//
+//	total, _ := MakeFromMinorUnits(0, USD)
+//	for _, item := range items {
+//	    total, err = total.Plus(item.Price)
+//	    if err != nil {
+//	        // handle error
+//	    }
+//	}
+
+// MakeFromMinorUnits returns a Money value from an amount in minor units and a currency code.
+// Returns an error if the currency code is invalid.
+//
+// Example:
+//
+//	m, err := MakeFromMinorUnits(1050, "USD") // $10.50
+func MakeFromMinorUnits(units int64, currencyCode string) (Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: units, currency: c}, nil
+}
+
+// MakeFromString returns a Money value from an exact decimal string, see NewFromString.
+//
+// Example:
+//
+//	m, err := MakeFromString("10.50", "USD")
+func MakeFromString(amount string, currencyCode string) (Money, error) {
+	c, err := lookupCurrency(currencyCode)
+	if err != nil {
+		return Money{}, err
+	}
+	units, err := parseDecimal(amount, c.MinorUnit)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: units, currency: c}, nil
+}
+
+// Sum adds one or more Money values together.
+// Returns an error if currencies don't match, if any currency is nil, or if overflow occurs.
+//
+// Example:
+//
+//	total, err := Sum(lineItems...)
+func Sum(ms ...Money) (Money, error) {
+	if len(ms) == 0 {
+		return Money{}, ErrNeedAtLeastOneMoney
+	}
+
+	reference := ms[0].currency
+	if reference == nil {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	var result int64
+	for _, money := range ms {
+		if !money.currency.sameAs(reference) {
+			return Money{}, ErrCurrencyMismatch
+		}
+		var err error
+		result, err = addInt64(result, money.amount)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+
+	return Money{amount: result, currency: reference}, nil
+}
+
+// Plus returns m + om.
+// Returns an error if currencies don't match or if overflow occurs.
+func (m Money) Plus(om Money) (Money, error) {
+	if m.currency == nil || !m.currency.sameAs(om.currency) {
+		return Money{}, ErrCurrencyMismatch
+	}
+	result, err := addInt64(m.amount, om.amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: result, currency: m.currency}, nil
+}
+
+// Minus returns m - om.
+// Returns an error if currencies don't match or if overflow occurs.
+func (m Money) Minus(om Money) (Money, error) {
+	if m.currency == nil || !m.currency.sameAs(om.currency) {
+		return Money{}, ErrCurrencyMismatch
+	}
+	result, err := subtractInt64(m.amount, om.amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: result, currency: m.currency}, nil
+}
+
+// Times returns m multiplied by factor.
+// Returns an error if the result would overflow int64.
+func (m Money) Times(factor int64) (Money, error) {
+	result, err := multiplyInt64(m.amount, factor)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: result, currency: m.currency}, nil
+}
+
+// DividedBy returns m divided by divisor, truncated toward zero like Divide.
+// Returns an error if divisor is zero.
+func (m Money) DividedBy(divisor int64) (Money, error) {
+	if divisor == 0 {
+		return Money{}, ErrDivisionByZero
+	}
+	if divisor == -1 && m.amount == math.MinInt64 {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: m.amount / divisor, currency: m.currency}, nil
+}
+
+// Neg returns the negative of m.
+func (m Money) Neg() Money {
+	return Money{amount: -m.amount, currency: m.currency}
+}
+
+// Abs returns the absolute value of m.
+func (m Money) Abs() Money {
+	return Money{amount: absInt64(m.amount), currency: m.currency}
+}
+
+// Rounded returns m rounded to whole major units with the given scheme, see Round.
+// Like Round it truncates the amounts that can't be rounded away from zero within
+// int64, use RoundTo to get an error instead.
+func (m Money) Rounded(scheme RoundScheme) Money {
+	return Money{amount: m.roundedAmount(scheme), currency: m.currency}
+}
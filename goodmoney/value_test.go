@@ -0,0 +1,141 @@
+package goodmoney
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	usd := func(units int64) Money {
+		m, _ := MakeFromMinorUnits(units, USD)
+		return m
+	}
+	eur, _ := MakeFromMinorUnits(100, EUR)
+
+	tests := []struct {
+		name       string
+		ms         []Money
+		wantAmount int64
+		wantErr    error
+	}{
+		{name: "single value", ms: []Money{usd(1050)}, wantAmount: 1050},
+		{name: "multiple values", ms: []Money{usd(1050), usd(2025), usd(-75)}, wantAmount: 3000},
+		{name: "no values", ms: nil, wantErr: ErrNeedAtLeastOneMoney},
+		{name: "currency mismatch", ms: []Money{usd(1050), eur}, wantErr: ErrCurrencyMismatch},
+		{name: "nil currency", ms: []Money{{amount: 1}}, wantErr: ErrCurrencyMismatch},
+		{name: "overflow", ms: []Money{usd(math.MaxInt64), usd(1)}, wantErr: ErrOverflow},
+		{name: "underflow", ms: []Money{usd(math.MinInt64), usd(-1)}, wantErr: ErrUnderflow},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Sum(tt.ms...)
+			if err != tt.wantErr {
+				t.Fatalf("Sum() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.amount != tt.wantAmount || got.Currency() != USD {
+				t.Errorf("Sum() = %s, want %d USD", got, tt.wantAmount)
+			}
+		})
+	}
+}
+
+func TestValueArithmetic(t *testing.T) {
+	t.Parallel()
+
+	a, _ := MakeFromString("10.50", USD)
+	b, _ := MakeFromString("2.25", USD)
+	eur, _ := MakeFromString("1.00", EUR)
+
+	check := func(name string, got Money, err error, want string) {
+		t.Helper()
+		if err != nil {
+			t.Errorf("%s unexpected error: %v", name, err)
+			return
+		}
+		if got.String() != want {
+			t.Errorf("%s = %s, want %s", name, got, want)
+		}
+	}
+
+	got, err := a.Plus(b)
+	check("Plus()", got, err, "12.75 USD")
+	got, err = a.Minus(b)
+	check("Minus()", got, err, "8.25 USD")
+	got, err = a.Times(3)
+	check("Times()", got, err, "31.50 USD")
+	got, err = a.DividedBy(4)
+	check("DividedBy()", got, err, "2.62 USD")
+	check("Neg()", a.Neg(), nil, "-10.50 USD")
+	check("Abs()", a.Neg().Abs(), nil, "10.50 USD")
+	check("Rounded()", a.Rounded(RoundHalfUp), nil, "11.00 USD")
+
+	if _, err := a.Plus(eur); err != ErrCurrencyMismatch {
+		t.Errorf("Plus() error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := a.Minus(eur); err != ErrCurrencyMismatch {
+		t.Errorf("Minus() error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := a.Times(math.MaxInt64); err != ErrOverflow {
+		t.Errorf("Times() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := a.DividedBy(0); err == nil {
+		t.Error("DividedBy(0) expected error, got nil")
+	}
+	minimum, _ := MakeFromMinorUnits(math.MinInt64, USD)
+	if _, err := minimum.DividedBy(-1); err != ErrOverflow {
+		t.Errorf("DividedBy(-1) error = %v, want %v", err, ErrOverflow)
+	}
+
+	// the value API must agree with the pointer API
+	sum, _ := Add(&a, &b)
+	plus, _ := a.Plus(b)
+	if *sum != plus {
+		t.Errorf("Add() = %s, Plus() = %s", sum, plus)
+	}
+}
+
+func TestMoneyAsMapKey(t *testing.T) {
+	t.Parallel()
+
+	a, _ := MakeFromString("10.50", USD)
+	b, _ := NewFromMinorUnits(1050, USD)
+	c, _ := MakeFromString("10.50", EUR)
+
+	if a != *b {
+		t.Error("equal Money values are not ==")
+	}
+	if a == c {
+		t.Error("Money values in different currencies are ==")
+	}
+
+	counts := map[Money]int{}
+	counts[a]++
+	counts[*b]++
+	counts[c]++
+	if counts[a] != 2 || counts[c] != 1 || len(counts) != 2 {
+		t.Errorf("map counts = %v, want 2 USD and 1 EUR", counts)
+	}
+}
+
+func TestMakeErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := MakeFromMinorUnits(1, "INVALID"); err != ErrCurrencyCodeDoesNotExist {
+		t.Errorf("MakeFromMinorUnits() error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+	if _, err := MakeFromString("1.00", "INVALID"); err != ErrCurrencyCodeDoesNotExist {
+		t.Errorf("MakeFromString() error = %v, want %v", err, ErrCurrencyCodeDoesNotExist)
+	}
+	if _, err := MakeFromString("1.001", USD); err != ErrTooManyDecimalPlaces {
+		t.Errorf("MakeFromString() error = %v, want %v", err, ErrTooManyDecimalPlaces)
+	}
+}